@@ -1,14 +1,20 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
-	"log/slog"      // Standard library slog
+	"log/slog" // Standard library slog
+	"os"
 	"path/filepath" // Import filepath
+	"sort"
+	"text/tabwriter"
 
 	"gydnc/model"
 	"gydnc/service"
 	"gydnc/storage"
 	"gydnc/storage/localfs"
+
+	"github.com/spf13/cobra"
 )
 
 var activeBackend storage.Backend
@@ -60,7 +66,7 @@ func InitActiveBackend() error {
 
 	backendN := cfg.DefaultBackend
 	if backendN == "" {
-		fmt.Println("Notice: No DefaultBackend specified in configuration. Some commands may not function.")
+		fmt.Fprintln(os.Stderr, "Notice: No DefaultBackend specified in configuration. Some commands may not function.")
 		activeBackend = nil
 		activeBackendName = ""
 		return nil
@@ -68,7 +74,15 @@ func InitActiveBackend() error {
 
 	storageCfg, ok := cfg.StorageBackends[backendN]
 	if !ok || storageCfg == nil {
-		fmt.Printf("Notice: Configuration for default backend '%s' not found. Some commands may not function.\n", backendN)
+		fmt.Fprintf(os.Stderr, "Notice: Configuration for default backend '%s' not found. Some commands may not function.\n", backendN)
+		activeBackend = nil
+		activeBackendName = ""
+		return nil
+	}
+
+	if storageCfg.Type == "inmem" {
+		// Configured inmem backends are read-only, so there is no active backend to write to
+		slog.Debug("[InitActiveBackend] Default backend is an in-memory backend; no active writable backend", "backend", backendN)
 		activeBackend = nil
 		activeBackendName = ""
 		return nil
@@ -168,3 +182,108 @@ func InitializeBackendFromConfig(backendName string, backendConfig *model.Storag
 
 	return localStore, nil
 }
+
+// BackendStatus describes a configured backend for the backends command.
+type BackendStatus struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Path     string `json:"path,omitempty"` // Resolved base path, for localfs backends
+	Default  bool   `json:"default"`
+	Writable bool   `json:"writable"`
+	OK       bool   `json:"ok"`
+	Error    string `json:"error,omitempty"`
+}
+
+var backendsCmd = &cobra.Command{
+	Use:   "backends",
+	Short: "List configured storage backends and whether they initialized",
+	Long: `Lists every storage backend in the configuration with its name, type, resolved path
+(for localfs backends), whether it is writable, and whether it initialized successfully.
+Backends that failed to initialize show the error, which helps diagnose a backend that
+is missing from 'list' output.
+
+Output is an aligned table by default; use --output json for a JSON array.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if appContext == nil || appContext.Config == nil {
+			return fmt.Errorf("application context or configuration not initialized")
+		}
+		if outputFormat != "" && outputFormat != "table" && outputFormat != "json" {
+			return fmt.Errorf("unsupported output format '%s' for backends (supported: table, json)", outputFormat)
+		}
+
+		backends, backendErrors := appContext.GetAllBackends()
+		names := make([]string, 0, len(appContext.Config.StorageBackends))
+		for name := range appContext.Config.StorageBackends {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		statuses := make([]BackendStatus, 0, len(names))
+		for _, name := range names {
+			backendConfig := appContext.Config.StorageBackends[name]
+			status := BackendStatus{Name: name, Default: name == appContext.Config.DefaultBackend}
+			if backendConfig != nil {
+				status.Type = backendConfig.Type
+			}
+			if backend, ok := backends[name]; ok {
+				status.OK = true
+				status.Writable = backend.IsWritable()
+				if pathed, ok := backend.(interface{ GetBasePath() string }); ok {
+					status.Path = pathed.GetBasePath()
+				}
+			} else {
+				status.Error = backendErrors[name].Error()
+				status.Path = configuredBackendPath(backendConfig)
+			}
+			if status.Path != "" {
+				if absPath, err := filepath.Abs(status.Path); err == nil {
+					status.Path = absPath
+				}
+			}
+			statuses = append(statuses, status)
+		}
+
+		if outputFormat == "json" {
+			jsonBytes, err := json.MarshalIndent(statuses, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal backends to JSON: %w", err)
+			}
+			fmt.Println(string(jsonBytes))
+			return nil
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "NAME\tTYPE\tPATH\tWRITABLE\tSTATUS")
+		for _, status := range statuses {
+			name := status.Name
+			if status.Default {
+				name += " (default)"
+			}
+			state := "ok"
+			if !status.OK {
+				state = "error: " + status.Error
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%t\t%s\n", name, status.Type, status.Path, status.Writable, state)
+		}
+		return w.Flush()
+	},
+	SilenceUsage: true,
+}
+
+// configuredBackendPath returns the localfs path of backendConfig resolved against the config
+// file's directory, as localfs.NewStore would, or "" for other backend types.
+func configuredBackendPath(backendConfig *model.StorageConfig) string {
+	if backendConfig == nil || backendConfig.LocalFS == nil {
+		return ""
+	}
+	path := backendConfig.LocalFS.Path
+	if path != "" && !filepath.IsAbs(path) && appContext.ConfigPath != "" {
+		path = filepath.Join(filepath.Dir(appContext.ConfigPath), path)
+	}
+	return path
+}
+
+func init() {
+	rootCmd.AddCommand(backendsCmd)
+}
@@ -0,0 +1,190 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"gydnc/model"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	benchCount   int
+	benchBackend string
+	benchNoWrite bool
+)
+
+// benchWritePrefix starts the alias prefix for entities written (and removed again) by bench;
+// each run adds a random suffix so it never touches aliases it did not create.
+const benchWritePrefix = "gydnc-bench-tmp"
+
+// BenchResult holds the timings of one benchmarked operation.
+type BenchResult struct {
+	Operation string        `json:"operation"`
+	Ops       int           `json:"ops"`
+	Errors    int           `json:"errors"`
+	OpsPerSec float64       `json:"ops_per_sec"`
+	P50       time.Duration `json:"p50_ns"`
+	P95       time.Duration `json:"p95_ns"`
+	Max       time.Duration `json:"max_ns"`
+}
+
+// newBenchResult summarizes per-operation latencies.
+func newBenchResult(operation string, latencies []time.Duration, errCount int) BenchResult {
+	result := BenchResult{Operation: operation, Ops: len(latencies), Errors: errCount}
+	if len(latencies) == 0 {
+		return result
+	}
+	sorted := make([]time.Duration, len(latencies))
+	copy(sorted, latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var total time.Duration
+	for _, l := range sorted {
+		total += l
+	}
+	if total > 0 {
+		result.OpsPerSec = float64(len(sorted)) / total.Seconds()
+	}
+	result.P50 = sorted[len(sorted)/2]
+	result.P95 = sorted[(len(sorted)*95)/100]
+	result.Max = sorted[len(sorted)-1]
+	return result
+}
+
+// benchCmd represents the bench command
+var benchCmd = &cobra.Command{
+	Use:   "bench",
+	Short: "Benchmark list, get and write performance of the configured backends",
+	Long: `Times list, get (random aliases) and write operations through the same
+EntityService and backend paths the other commands use, and reports ops/sec and
+p50/p95/max latencies per operation.
+
+Writes create new temporary aliases under a random per-run prefix starting with
+'` + benchWritePrefix + `-' in the target backend (--backend, or the default backend) and
+are deleted again afterwards; an alias that already exists is never overwritten. The write
+benchmark is skipped with a warning when the target backend has git_autocommit enabled, so
+it does not add commits to the repository. Use --no-write to benchmark read-only.
+
+Use --count to control the number of iterations per operation, and --output json
+for machine-readable results.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if appContext == nil || appContext.Config == nil || appContext.EntityService == nil {
+			return fmt.Errorf("application context, configuration, or entity service not initialized")
+		}
+		if benchCount < 1 {
+			return fmt.Errorf("--count must be at least 1, got %d", benchCount)
+		}
+		entityService := appContext.EntityService
+		var results []BenchResult
+
+		// list
+		var latencies []time.Duration
+		var entities []model.Entity
+		listErrors := 0
+		for i := 0; i < benchCount; i++ {
+			start := time.Now()
+			listed, backendErrors := entityService.ListEntitiesMerged("", "")
+			latencies = append(latencies, time.Since(start))
+			if len(backendErrors) > 0 {
+				listErrors++
+			}
+			entities = listed
+		}
+		results = append(results, newBenchResult("list", latencies, listErrors))
+
+		// get
+		if len(entities) == 0 {
+			appContext.Logger.Warn("No entities found; skipping get benchmark")
+		} else {
+			latencies = nil
+			getErrors := 0
+			for i := 0; i < benchCount; i++ {
+				alias := entities[rand.IntN(len(entities))].Alias
+				start := time.Now()
+				_, err := entityService.GetEntity(alias, "")
+				latencies = append(latencies, time.Since(start))
+				if err != nil {
+					getErrors++
+					appContext.Logger.Debug("bench get failed", "alias", alias, "error", err)
+				}
+			}
+			results = append(results, newBenchResult("get", latencies, getErrors))
+		}
+
+		// write
+		writeBackend := benchBackend
+		if writeBackend == "" {
+			writeBackend = appContext.Config.DefaultBackend
+		}
+		skipWrite := benchNoWrite
+		if backendCfg, ok := appContext.Config.StorageBackends[writeBackend]; ok && !skipWrite {
+			if backendCfg.LocalFS != nil && backendCfg.LocalFS.GitAutocommit {
+				appContext.Logger.Warn("Backend has git_autocommit enabled; skipping write benchmark", "backend", writeBackend)
+				skipWrite = true
+			}
+		}
+		if !skipWrite {
+			latencies = nil
+			writeErrors := 0
+			var written []string
+			var writtenBackend string
+			prefix := fmt.Sprintf("%s-%08x", benchWritePrefix, rand.Uint32())
+			for i := 0; i < benchCount; i++ {
+				entity := model.Entity{
+					Alias: fmt.Sprintf("%s/entity-%d", prefix, i),
+					Title: fmt.Sprintf("Bench entity %d", i),
+					Tags:  []string{"bench"},
+					Body:  "Temporary entity written by gydnc bench.\n",
+				}
+				start := time.Now()
+				backendName, err := entityService.SaveEntity(entity, benchBackend)
+				latencies = append(latencies, time.Since(start))
+				if err != nil {
+					writeErrors++
+					appContext.Logger.Debug("bench write failed", "alias", entity.Alias, "error", err)
+					continue
+				}
+				written = append(written, entity.Alias)
+				writtenBackend = backendName
+			}
+			results = append(results, newBenchResult("write", latencies, writeErrors))
+
+			for _, alias := range written {
+				if err := entityService.DeleteEntity(alias, writtenBackend); err != nil {
+					appContext.Logger.Warn("Failed to clean up bench entity", "alias", alias, "backend", writtenBackend, "error", err)
+				}
+			}
+		}
+
+		if outputFormat == "json" {
+			jsonBytes, err := json.MarshalIndent(results, "", "  ")
+			if err != nil {
+				return fmt.Errorf("marshalling bench results to JSON: %w", err)
+			}
+			fmt.Println(string(jsonBytes))
+			return nil
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "OPERATION\tOPS\tERRORS\tOPS/SEC\tP50\tP95\tMAX")
+		for _, r := range results {
+			fmt.Fprintf(w, "%s\t%d\t%d\t%.1f\t%s\t%s\t%s\n", r.Operation, r.Ops, r.Errors, r.OpsPerSec, r.P50, r.P95, r.Max)
+		}
+		return w.Flush()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(benchCmd)
+	benchCmd.Flags().IntVar(&benchCount, "count", 100, "Number of iterations per operation")
+	benchCmd.Flags().StringVar(&benchBackend, "backend", "", "Backend to write to (defaults to the default backend)")
+	benchCmd.Flags().BoolVar(&benchNoWrite, "no-write", false, "Skip the write benchmark")
+}
@@ -1,8 +1,14 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
+	"io/fs"
 	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"syscall"
 
 	"gydnc/service"
 
@@ -53,6 +59,173 @@ var configViewCmd = &cobra.Command{
 	},
 }
 
+var configPathCmd = &cobra.Command{
+	Use:   "path",
+	Short: "Print the path of the configuration file in effect",
+	Long: `Prints the absolute path of the configuration file gydnc uses, resolved in order from
+--config, the GYDNC_CONFIG environment variable, and the nearest .gydnc/config.yml in the
+current directory or its parents. If none is found, prints "(using defaults)" and exits
+with an error. When GYDNC_CONFIG is a path list of merged config files, each file is printed
+on its own line in merge order.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		configService := service.NewConfigService(service.NewAppContext(nil, nil))
+		configPath, err := configService.GetEffectiveConfigPath(cfgFile)
+		if err != nil {
+			fmt.Println("(using defaults)")
+			return fmt.Errorf("no configuration file found: %w", err)
+		}
+		for _, path := range filepath.SplitList(configPath) {
+			if path == "" {
+				continue
+			}
+			absPath, err := filepath.Abs(path)
+			if err != nil {
+				return fmt.Errorf("failed to resolve absolute path for '%s': %w", path, err)
+			}
+			fmt.Println(absPath)
+		}
+		return nil
+	},
+	SilenceUsage: true,
+}
+
+var configBackendsCmd = &cobra.Command{
+	Use:   "backends",
+	Short: "Edit storage backend settings",
+}
+
+var configBackendsSetPathCmd = &cobra.Command{
+	Use:   "set-path <name> <path>",
+	Short: "Set the directory of a localfs backend",
+	Long: `Sets storage_backends.<name>.localfs.path in the configuration file in effect, e.g. after
+moving a backend's directory. The backend must exist and be of type localfs. The path is
+stored as given: a relative path is resolved against the config file's directory when the
+backend is used. The configuration file is rewritten, so comments in it are not kept.`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name, path := args[0], args[1]
+		if appContext == nil || appContext.ConfigPath == "" {
+			return fmt.Errorf("configuration not loaded; run 'gydnc init' or check config")
+		}
+		configService := service.NewConfigService(appContext)
+		if err := configService.SetBackendPath(appContext.ConfigPath, name, path); err != nil {
+			return err
+		}
+		fmt.Printf("Set path of backend '%s' to '%s' in %s\n", name, path, appContext.ConfigPath)
+		return nil
+	},
+	SilenceUsage: true,
+}
+
+var configValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Check the configuration in effect and report every problem",
+	Long: `Checks the configuration in effect and prints each problem on its own line, prefixed
+with "error:". The checks are those run when the configuration is loaded (default_backend
+is defined, every backend has a name and a supported type, localfs backends have a path),
+plus a check that each localfs backend's resolved path is a directory or can be created.
+A localfs backend whose directory is empty is reported with a "warning:" prefix.
+
+A configuration file that cannot be read or parsed is reported as an error too.
+
+Exits non-zero if any error is found, so CI can check config health without running a
+data command.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if appContext == nil || appContext.Config == nil {
+			// initConfig leaves the config unset when it cannot be loaded; load it again for the reason
+			configService := service.NewConfigService(service.NewAppContext(nil, nil))
+			configPath, err := configService.GetEffectiveConfigPath(cfgFile)
+			if err == nil {
+				_, err = configService.LoadFromPath(configPath, true)
+			}
+			if err == nil {
+				return fmt.Errorf("configuration not loaded; run 'gydnc init' or check config")
+			}
+			fmt.Printf("error: %v\n", err)
+			return fmt.Errorf("configuration %s cannot be loaded", configPath)
+		}
+		cfg := appContext.Config
+
+		var problems []string
+		for _, err := range cfg.Validate() {
+			problems = append(problems, err.Error())
+		}
+
+		names := make([]string, 0, len(cfg.StorageBackends))
+		for name := range cfg.StorageBackends {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		var warnings []string
+		for _, name := range names {
+			path := configuredBackendPath(cfg.StorageBackends[name])
+			if path == "" {
+				continue
+			}
+			problem, warning := checkBackendDir(name, path)
+			if problem != "" {
+				problems = append(problems, problem)
+			}
+			if warning != "" {
+				warnings = append(warnings, warning)
+			}
+		}
+
+		for _, problem := range problems {
+			fmt.Printf("error: %s\n", problem)
+		}
+		for _, warning := range warnings {
+			fmt.Printf("warning: %s\n", warning)
+		}
+		if len(problems) > 0 {
+			return fmt.Errorf("configuration %s has %d problem(s)", appContext.ConfigPath, len(problems))
+		}
+		fmt.Printf("Configuration %s is valid\n", appContext.ConfigPath)
+		return nil
+	},
+	SilenceUsage: true,
+}
+
+// checkBackendDir checks that the resolved path of localfs backend name is a directory, or
+// that it can be created because its nearest existing ancestor is one. It returns a problem
+// if not, and a warning if the directory exists but is empty.
+func checkBackendDir(name string, path string) (problem string, warning string) {
+	info, err := os.Stat(path)
+	if err == nil {
+		if !info.IsDir() {
+			return fmt.Sprintf("localfs backend '%s' path %s is not a directory", name, path), ""
+		}
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return fmt.Sprintf("localfs backend '%s' directory %s cannot be read: %v", name, path, err), ""
+		}
+		if len(entries) == 0 {
+			return "", fmt.Sprintf("localfs backend '%s' directory %s is empty", name, path)
+		}
+		return "", ""
+	}
+	// ENOTDIR means a parent of path is a file, which the ancestor walk below reports
+	if !errors.Is(err, fs.ErrNotExist) && !errors.Is(err, syscall.ENOTDIR) {
+		return fmt.Sprintf("localfs backend '%s' path %s cannot be accessed: %v", name, path, err), ""
+	}
+
+	for ancestor := filepath.Dir(path); ; ancestor = filepath.Dir(ancestor) {
+		info, err := os.Stat(ancestor)
+		if err == nil {
+			if !info.IsDir() {
+				return fmt.Sprintf("localfs backend '%s' path %s cannot be created: %s is not a directory", name, path, ancestor), ""
+			}
+			return "", ""
+		}
+		if !errors.Is(err, fs.ErrNotExist) || filepath.Dir(ancestor) == ancestor {
+			return fmt.Sprintf("localfs backend '%s' path %s cannot be created: %v", name, path, err), ""
+		}
+	}
+}
+
 var configGetCmd = &cobra.Command{
 	Use:   "get [key]",
 	Short: "Get a specific configuration value (Not implemented in MVP)",
@@ -83,6 +256,10 @@ var configSetCmd = &cobra.Command{
 func init() {
 	rootCmd.AddCommand(configCmd)
 	configCmd.AddCommand(configViewCmd)
+	configCmd.AddCommand(configPathCmd)
+	configCmd.AddCommand(configValidateCmd)
+	configCmd.AddCommand(configBackendsCmd)
+	configBackendsCmd.AddCommand(configBackendsSetPathCmd)
 	configCmd.AddCommand(configGetCmd)
 	configCmd.AddCommand(configSetCmd)
 
@@ -2,32 +2,57 @@ package cmd
 
 import (
 	"bufio"
+	"bytes"
+	"encoding/json"
 	"errors" // Added for errors.Is
 	"fmt"
+	"io"
 	"log/slog" // To be used for debug logging
 	"os"
-
+	"os/exec"
+	"path/filepath"
 	"strings"
+	"text/template"
 
 	// For GuidanceContent and ToFileContent
+	"gydnc/core/content"
 	"gydnc/model"
 	"gydnc/storage" // Added for storage.ErrAmbiguousBackend
 
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 )
 
 var (
-	createTitle        string
-	createDescription  string
-	createTags         []string
-	createBackend      string // Added for backend selection
-	createBodyFromFile string
-	createBody         string
+	createTitle          string
+	createDescription    string
+	createTags           []string
+	createBackend        string // Added for backend selection
+	createBodyFromFile   string
+	createBody           string
+	createStrictTags     bool
+	createOverwrite      bool
+	createAliasFromTitle bool
+	createNoDuplicates   bool
+	createTemplate       string
+	createEdit           bool
+	createFromStdin      bool
+	createFormat         string
+)
+
+// defaultEditor is run by create --edit when $EDITOR is not set.
+const defaultEditor = "vi"
+
+// Body templates for create --template live in this directory next to the config file,
+// as <name> plus templateFileExt.
+const (
+	templatesDirName = "templates"
+	templateFileExt  = ".md"
 )
 
 // createCmd represents the create command
 var createCmd = &cobra.Command{
-	Use:   "create <alias_or_path>",
+	Use:   "create [alias_or_path]",
 	Short: "Create a new guidance entity",
 	Long: `Creates a new guidance entity using the EntityService.
 
@@ -35,11 +60,48 @@ The alias_or_path is used as the entity's alias.
 Metadata (title, description, tags) is provided via flags.
 Body content can be provided via stdin, --body, or --body-from-file.
 
-The command will fail if the entity already exists in the target backend.
+The command will fail if the entity already exists in the target backend,
+unless --overwrite is given, in which case an existing entity is replaced.
+With --alias-from-title, the alias may be omitted (or given as '-') and is derived
+from --title: lowercased, spaces turned into hyphens, punctuation removed. If that
+alias is taken, a numeric suffix is added (error-handling-2, ...) unless --overwrite
+is given. The derived alias is printed on stdout.
+With --strict-tags, tags must be defined in the tag_ontology.md next to the config file.
+With --template <name>, the body is rendered from templates/<name>.md next to the config
+file using Go text/template, with .Alias, .Title and .Tags available, e.g.:
+  # {{.Title}}
+
+  Applies to: {{range .Tags}}{{.}} {{end}}
+The rendered template is used only if no other body source is supplied; a missing
+template is an error.
+If another alias (in any backend) already has an identical body, i.e. the same content ID,
+a warning listing it is logged; with --no-duplicates the entity is not created instead.
+With --from-stdin, stdin holds a complete entity instead of just the body, as a JSON
+object (the default) or, with --format yaml, a YAML document, with the fields title,
+description, tags, custom_metadata and body, e.g.:
+  echo '{"title": "No Panics", "tags": ["scope:code"], "body": "Return errors."}' | gydnc create no-panics --from-stdin
+--title, --description and --tags take precedence over the fields on stdin. --from-stdin
+cannot be combined with --body, --body-from-file or --template, and stdin is then never
+read as the body.
+With --edit, the new entity is opened in $EDITOR (vi if unset) before it is saved, as a
+.g6e file with its frontmatter, and is saved as edited when the editor exits. If the editor
+fails or the file is left unchanged, the entity is saved as drafted. The edited entity goes
+through the same --strict-tags and duplicate content checks.
 All write operations are handled by the configured storage backend via the EntityService.`,
-	Args: cobra.ExactArgs(1),
+	Args: func(cmd *cobra.Command, args []string) error {
+		if createAliasFromTitle {
+			return cobra.RangeArgs(0, 1)(cmd, args)
+		}
+		return cobra.ExactArgs(1)(cmd, args)
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
-		alias := args[0] // Changed from aliasOrPath to just alias, as path resolution is now backend's concern
+		alias := "" // Path resolution is the backend's concern; the argument is used as the alias
+		if len(args) > 0 {
+			alias = args[0]
+		}
+		if createAliasFromTitle && alias != "" && alias != "-" {
+			return fmt.Errorf("--alias-from-title cannot be combined with an explicit alias '%s'; omit it or pass '-'", alias)
+		}
 		slog.Debug("Starting 'create' command with EntityService",
 			"alias", alias,
 			"title", createTitle,
@@ -55,6 +117,45 @@ All write operations are handled by the configured storage backend via the Entit
 			return fmt.Errorf("application context, configuration, or entity service not initialized")
 		}
 
+		// With --from-stdin the entity's fields come from stdin; flags given explicitly take precedence
+		var stdinEntity *model.Entity
+		if createFromStdin {
+			if cmd.Flags().Changed("body") || cmd.Flags().Changed("body-from-file") || createTemplate != "" {
+				return fmt.Errorf("--from-stdin cannot be combined with --body, --body-from-file or --template")
+			}
+			decoded, err := decodeStdinEntity(alias, createFormat)
+			if err != nil {
+				return err
+			}
+			stdinEntity = &decoded
+			if !cmd.Flags().Changed("title") {
+				createTitle = decoded.Title
+			}
+			if !cmd.Flags().Changed("description") {
+				createDescription = decoded.Description
+			}
+			if !cmd.Flags().Changed("tags") && decoded.Tags != nil {
+				createTags = decoded.Tags
+			}
+		} else if cmd.Flags().Changed("format") {
+			return fmt.Errorf("--format requires --from-stdin")
+		}
+
+		if createStrictTags {
+			if err := checkStrictTags(createTags); err != nil {
+				return err
+			}
+		}
+
+		if createAliasFromTitle {
+			var err error
+			alias, err = aliasFromTitle(createTitle, createBackend, createOverwrite)
+			if err != nil {
+				return err
+			}
+			slog.Debug("Derived alias from title", "title", createTitle, "alias", alias)
+		}
+
 		// Determine body content
 		var actualBodyContent string
 		var bodySourceUsed bool
@@ -63,7 +164,8 @@ All write operations are handled by the configured storage backend via the Entit
 		bodyFlagUsed := cmd.Flags().Changed("body")
 
 		stat, _ := os.Stdin.Stat()
-		stdinIsPiped := (stat.Mode() & os.ModeCharDevice) == 0
+		// With --from-stdin, stdin has already been read as the whole entity
+		stdinIsPiped := (stat.Mode()&os.ModeCharDevice) == 0 && stdinEntity == nil
 
 		sourcesProvided := 0
 		if bodyFromFileFlagUsed {
@@ -80,7 +182,13 @@ All write operations are handled by the configured storage backend via the Entit
 			return fmt.Errorf("multiple body sources provided (--body-from-file, --body, stdin); please use only one")
 		}
 
-		if bodyFromFileFlagUsed {
+		if stdinEntity != nil {
+			actualBodyContent = stdinEntity.Body
+			if actualBodyContent != "" && !strings.HasSuffix(actualBodyContent, "\n") {
+				actualBodyContent += "\n"
+			}
+			bodySourceUsed = true
+		} else if bodyFromFileFlagUsed {
 			bodyBytes, err := os.ReadFile(createBodyFromFile)
 			if err != nil {
 				return fmt.Errorf("failed to read body from file '%s': %w", createBodyFromFile, err)
@@ -118,9 +226,20 @@ All write operations are handled by the configured storage backend via the Entit
 		// Use default title if not provided - user wants blank if not specified
 		titleToUse := createTitle
 
-		// Use default body if none provided
+		var templateBody string
+		if createTemplate != "" {
+			var err error
+			templateBody, err = renderBodyTemplate(createTemplate, alias, titleToUse, createTags)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Use the template, or else the default body, if none provided
 		if !bodySourceUsed || actualBodyContent == "" {
-			if titleToUse == "" {
+			if createTemplate != "" && !bodySourceUsed {
+				actualBodyContent = templateBody
+			} else if titleToUse == "" {
 				actualBodyContent = "#\n\nGuidance content for '' goes here.\n" // Corrected: '' for empty title placeholder
 			} else {
 				actualBodyContent = fmt.Sprintf("# %s\n\nGuidance content for '%s' goes here.\n", titleToUse, titleToUse)
@@ -137,11 +256,37 @@ All write operations are handled by the configured storage backend via the Entit
 			// CID and PCID will be handled by the backend/storage layer or if they become part of standard creation flow
 			// CustomMetadata can be added here if there's a mechanism to pass it via flags, for now it's empty.
 		}
+		if stdinEntity != nil {
+			entityToSave.CustomMetadata = stdinEntity.CustomMetadata
+		}
+
+		if createEdit {
+			edited, err := editEntityInEditor(entityToSave)
+			if err != nil {
+				return err
+			}
+			if createStrictTags {
+				if err := checkStrictTags(edited.Tags); err != nil {
+					return err
+				}
+			}
+			entityToSave = edited
+		}
+
+		if err := checkDuplicateContent(entityToSave); err != nil {
+			return err
+		}
 
 		slog.Debug("Attempting to save entity via EntityService", "alias", entityToSave.Alias, "backend", createBackend)
 
-		// Save the entity using EntityService
-		savedBackendName, err := appContext.EntityService.SaveEntity(entityToSave, createBackend)
+		// Save the entity using EntityService; --overwrite replaces an existing entity instead of failing
+		var savedBackendName string
+		var err error
+		if createOverwrite {
+			savedBackendName, err = appContext.EntityService.OverwriteEntity(entityToSave, createBackend)
+		} else {
+			savedBackendName, err = appContext.EntityService.SaveEntity(entityToSave, createBackend)
+		}
 		if err != nil {
 			slog.Error("Failed to save entity using EntityService", "alias", alias, "error", err)
 			if errors.Is(err, storage.ErrAmbiguousBackend) {
@@ -151,13 +296,200 @@ All write operations are handled by the configured storage backend via the Entit
 		}
 
 		slog.Info("Successfully created guidance.", "alias", alias, "backend", savedBackendName)
-
+		if createAliasFromTitle {
+			fmt.Println(alias)
+		}
 		return nil
 	},
 	SilenceErrors: true,
 	SilenceUsage:  true,
 }
 
+// checkDuplicateContent looks for entities under other aliases whose body has the same CID as
+// entity's. It logs a warning listing them, or returns an error with --no-duplicates.
+func checkDuplicateContent(entity model.Entity) error {
+	gc := content.GuidanceContent{Body: entity.Body}
+	cid, err := gc.GetContentID()
+	if err != nil {
+		return fmt.Errorf("failed to compute content ID: %w", err)
+	}
+	matches, _ := appContext.EntityService.FindEntitiesByCID(cid)
+	var existing []string
+	for _, match := range matches {
+		if match.Alias != entity.Alias {
+			existing = append(existing, fmt.Sprintf("%s (backend: %s)", match.Alias, match.SourceBackend))
+		}
+	}
+	if len(existing) == 0 {
+		return nil
+	}
+	if createNoDuplicates {
+		return fmt.Errorf("failed to create guidance '%s': identical content already exists as %s", entity.Alias, strings.Join(existing, ", "))
+	}
+	slog.Warn("Identical content already exists under another alias", "alias", entity.Alias, "existing", strings.Join(existing, ", "))
+	return nil
+}
+
+// decodeStdinEntity decodes the entity given to create --from-stdin in format (json or yaml).
+// Unknown fields are rejected so typos don't silently drop data, and an alias in the input
+// must match the alias being created.
+func decodeStdinEntity(alias string, format string) (model.Entity, error) {
+	var entity model.Entity
+	stat, _ := os.Stdin.Stat()
+	if (stat.Mode() & os.ModeCharDevice) != 0 {
+		return entity, fmt.Errorf("--from-stdin requires an entity to be piped to stdin")
+	}
+	data, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return entity, fmt.Errorf("error reading entity from stdin: %w", err)
+	}
+
+	switch format {
+	case "json":
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(&entity)
+	case "yaml":
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		err = decoder.Decode(&entity)
+	default:
+		return entity, fmt.Errorf("unsupported format '%s' for --from-stdin (supported: json, yaml)", format)
+	}
+	if err != nil {
+		return entity, fmt.Errorf("failed to decode %s entity from stdin: %w", format, err)
+	}
+
+	if entity.Alias != "" && alias != "" && alias != "-" && entity.Alias != alias {
+		return entity, fmt.Errorf("alias '%s' on stdin does not match the alias being created '%s'", entity.Alias, alias)
+	}
+	for key := range entity.CustomMetadata {
+		if err := checkCustomMetaKey(key); err != nil {
+			return entity, err
+		}
+	}
+	return entity, nil
+}
+
+// editEntityInEditor opens the draft entity in $EDITOR as a temporary .g6e file and returns
+// it as edited. The draft is returned as it is if the editor exits with an error or the file
+// is unchanged.
+func editEntityInEditor(entity model.Entity) (model.Entity, error) {
+	gc := content.GuidanceContent{
+		Title:          entity.Title,
+		Description:    entity.Description,
+		Tags:           entity.Tags,
+		CustomMetadata: content.JoinPCID(entity.CustomMetadata, entity.PCID),
+		Body:           entity.Body,
+	}
+	original, err := gc.ToFileContent()
+	if err != nil {
+		return entity, fmt.Errorf("failed to serialize '%s' for editing: %w", entity.Alias, err)
+	}
+
+	tmpFile, err := os.CreateTemp("", "gydnc-edit-*.g6e")
+	if err != nil {
+		return entity, fmt.Errorf("failed to create temporary file for editing: %w", err)
+	}
+	tmpPath := tmpFile.Name()
+	defer os.Remove(tmpPath)
+	if _, err := tmpFile.Write(original); err != nil {
+		tmpFile.Close()
+		return entity, fmt.Errorf("failed to write temporary file for editing: %w", err)
+	}
+	if err := tmpFile.Close(); err != nil {
+		return entity, fmt.Errorf("failed to write temporary file for editing: %w", err)
+	}
+
+	editor := strings.Fields(os.Getenv("EDITOR"))
+	if len(editor) == 0 {
+		editor = []string{defaultEditor}
+	}
+	editorCmd := exec.Command(editor[0], append(editor[1:], tmpPath)...)
+	editorCmd.Stdin = os.Stdin
+	editorCmd.Stdout = os.Stdout
+	editorCmd.Stderr = os.Stderr
+	if err := editorCmd.Run(); err != nil {
+		slog.Warn("Editor did not exit cleanly; saving entity as drafted", "alias", entity.Alias, "editor", editor[0], "error", err)
+		return entity, nil
+	}
+
+	edited, err := os.ReadFile(tmpPath)
+	if err != nil {
+		return entity, fmt.Errorf("failed to read edited file: %w", err)
+	}
+	if bytes.Equal(edited, original) {
+		slog.Info("No changes made in editor; saving entity as drafted", "alias", entity.Alias)
+		return entity, nil
+	}
+	parsed, err := content.ParseG6E(edited)
+	if err != nil {
+		return entity, fmt.Errorf("edited content of '%s' is not valid, entity not created: %w", entity.Alias, err)
+	}
+
+	pcid, custom := content.SplitPCID(parsed.CustomMetadata)
+	entity.Title = parsed.Title
+	entity.Description = parsed.Description
+	entity.Tags = parsed.Tags
+	entity.CustomMetadata = custom
+	entity.PCID = pcid
+	entity.Body = parsed.Body
+	return entity, nil
+}
+
+// renderBodyTemplate executes the named body template from the templates directory next to
+// the config file with the new entity's alias, title and tags.
+func renderBodyTemplate(name, alias, title string, tags []string) (string, error) {
+	if name != filepath.Base(name) || strings.HasPrefix(name, ".") {
+		return "", fmt.Errorf("invalid template name '%s'", name)
+	}
+	templatePath := filepath.Join(filepath.Dir(appContext.ConfigPath), templatesDirName, name+templateFileExt)
+	tmplBytes, err := os.ReadFile(templatePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("template '%s' not found (looked for %s)", name, templatePath)
+		}
+		return "", fmt.Errorf("failed to read template '%s': %w", name, err)
+	}
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(string(tmplBytes))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse template '%s': %w", name, err)
+	}
+	var rendered strings.Builder
+	data := struct {
+		Alias string
+		Title string
+		Tags  []string
+	}{Alias: alias, Title: title, Tags: tags}
+	if err := tmpl.Execute(&rendered, data); err != nil {
+		return "", fmt.Errorf("failed to render template '%s': %w", name, err)
+	}
+	body := rendered.String()
+	if body != "" && !strings.HasSuffix(body, "\n") {
+		body += "\n"
+	}
+	return body, nil
+}
+
+// aliasFromTitle slugifies title into an alias. Unless overwrite is set, a numeric suffix
+// is appended while the alias already exists in backendName (or any backend if empty).
+func aliasFromTitle(title string, backendName string, overwrite bool) (string, error) {
+	base := content.SlugifyTitle(title)
+	if base == "" {
+		return "", fmt.Errorf("--alias-from-title requires a --title containing letters or digits")
+	}
+	if overwrite {
+		return base, nil
+	}
+	alias := base
+	for n := 2; ; n++ {
+		if _, err := appContext.EntityService.GetEntity(alias, backendName); err != nil {
+			return alias, nil
+		}
+		alias = fmt.Sprintf("%s-%d", base, n)
+	}
+}
+
 func init() {
 	rootCmd.AddCommand(createCmd)
 
@@ -167,6 +499,14 @@ func init() {
 	createCmd.Flags().StringVar(&createBackend, "backend", "", "Name of the storage backend to use (overrides default_backend from config)") // Added flag
 	createCmd.Flags().StringVar(&createBodyFromFile, "body-from-file", "", "Path to a file containing the body for the new guidance")
 	createCmd.Flags().StringVar(&createBody, "body", "", "Direct string content for the body of the new guidance")
+	createCmd.Flags().BoolVar(&createOverwrite, "overwrite", false, "Replace the entity if it already exists instead of failing")
+	createCmd.Flags().BoolVar(&createAliasFromTitle, "alias-from-title", false, "Derive the alias from --title when the alias is omitted or '-'")
+	createCmd.Flags().BoolVar(&createNoDuplicates, "no-duplicates", false, "Refuse to create the entity if another alias already has identical content")
+	createCmd.Flags().BoolVar(&createFromStdin, "from-stdin", false, "Read the whole entity (title, description, tags, custom_metadata, body) from stdin instead of just the body")
+	createCmd.Flags().StringVar(&createFormat, "format", "json", "Format of the entity read with --from-stdin (json, yaml)")
+	createCmd.Flags().BoolVar(&createEdit, "edit", false, "Open the new entity in $EDITOR before saving it")
+	createCmd.Flags().StringVar(&createTemplate, "template", "", "Render the body from templates/<name>.md next to the config file if no other body source is given")
+	createCmd.Flags().BoolVar(&createStrictTags, "strict-tags", false, "Reject tags not defined in the tag ontology file")
 	// Example of how to use a StringArray flag if preferred over StringSlice for comma separation handling by Cobra
 	// createCmd.Flags().StringArrayVarP(&createTags, "tags", "g", []string{}, "Tags for the new guidance (can be specified multiple times)")
 }
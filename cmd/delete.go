@@ -4,24 +4,50 @@ import (
 	"bufio"
 	"fmt"
 	"os"
+	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 
 	"gydnc/core/content"
 	"gydnc/model"
+	"gydnc/storage"
 
 	"log/slog"
 
 	"github.com/spf13/cobra"
 )
 
-var forceDelete bool
+var (
+	forceDelete      bool
+	deleteKeepBackup string
+	deleteDryRun     bool
+	deleteBackend    string
+	deleteIfCID      string
+)
 
 var deleteCmd = &cobra.Command{
 	Use:   "delete <alias1> [alias2 ...]",
 	Short: "Delete one or more guidance entities by alias (from all backends)",
 	Long: `Deletes one or more guidance entities by alias. Searches all configured backends for each alias.
-Requires confirmation unless --force is specified.`,
+Requires confirmation unless --force is specified.
+
+With --dry-run, the entities that would be deleted are listed (with backend, path and
+title) without prompting or deleting anything, e.g. to review before running with --force.
+
+With --keep-backup <dir>, each entity's stored content is copied to
+<dir>/<backend>/<alias>.g6e before it is deleted, so a mistaken delete can be undone by
+copying the file back. An entity whose backup cannot be written is not deleted.
+
+Use --backend <name> to delete only the copy in that backend. Use --if-cid <cid> to
+delete only if the entity's current content ID (CID, as shown by 'get --extended' or
+'hash') still matches, e.g. so that an entity someone else just modified is not removed:
+  gydnc delete my-rule --backend team --if-cid 3f2a... --force
+If any matched entity has a different CID, nothing is deleted and the command fails. The
+CID is checked again immediately before each deletion.
+
+The command fails if none of the aliases are found, or if any matched entity could not be
+deleted (the others are still deleted).`,
 	Args: cobra.MinimumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		aliases := args
@@ -32,66 +58,43 @@ Requires confirmation unless --force is specified.`,
 		}
 
 		cfg := appContext.Config
-		var toDelete []model.Entity
-		var notFound []string
+		if deleteBackend != "" {
+			if _, ok := cfg.StorageBackends[deleteBackend]; !ok {
+				return fmt.Errorf("backend '%s' is not configured: %w", deleteBackend, storage.ErrBackendNotFound)
+			}
+		}
 
-		// Track which aliases have been found
-		foundAliases := make(map[string]bool)
+		// Each alias is read directly from each backend rather than matched against full listings
+		toDelete, notFound := appContext.EntityService.FindEntitiesByAlias(aliases)
+		if deleteBackend != "" {
+			toDelete, notFound = filterDeleteBackend(aliases, toDelete, deleteBackend)
+		}
+		for _, e := range toDelete {
+			slog.Debug("Entity marked for deletion", "entity", e)
+		}
 
-		for backendName, backendConfig := range cfg.StorageBackends {
-			backend, err := InitializeBackendFromConfig(backendName, backendConfig)
-			if err != nil {
-				continue
-			}
-			entityIDs, err := backend.List("")
-			if err != nil {
-				continue
-			}
-			slog.Debug("Entity IDs found in backend", "backendName", backendName, "entityIDs", entityIDs)
-			for _, entityID := range entityIDs {
-				for _, alias := range aliases {
-					slog.Debug("Matching alias", "alias", alias, "entityID", entityID)
-					if entityID == alias {
-						contentBytes, meta, err := backend.Read(entityID)
-						if err == nil && meta != nil {
-							parsed, _ := content.ParseG6E(contentBytes)
-							entity := model.Entity{
-								Alias:          entityID,
-								SourceBackend:  backendName,
-								Title:          parsed.Title,
-								Description:    parsed.Description,
-								Tags:           parsed.Tags,
-								CustomMetadata: meta,
-								Body:           parsed.Body,
-							}
-							cid, _ := parsed.GetContentID()
-							entity.CID = cid
-							toDelete = append(toDelete, entity)
-							foundAliases[alias] = true
-							slog.Debug("Entity marked for deletion", "entity", entity)
-						}
-					}
+		if deleteIfCID != "" {
+			var mismatched []string
+			for _, e := range toDelete {
+				if e.CID != deleteIfCID {
+					mismatched = append(mismatched, fmt.Sprintf("%s (backend: %s) has CID %s", e.Alias, e.SourceBackend, e.CID))
 				}
 			}
-		}
-
-		// Track not found aliases
-		for _, alias := range aliases {
-			if !foundAliases[alias] {
-				notFound = append(notFound, alias)
+			if len(mismatched) > 0 {
+				return fmt.Errorf("content changed, not deleting: %s; expected CID %s", strings.Join(mismatched, ", "), deleteIfCID)
 			}
 		}
 
 		if len(toDelete) == 0 {
 			appContext.Logger.Info("No matching entities found to delete.")
 			if len(notFound) > 0 {
-				appContext.Logger.Info("Some aliases provided were not found.", "aliases", strings.Join(notFound, ", "))
+				return fmt.Errorf("no entities found to delete for %s: %w", strings.Join(notFound, ", "), storage.ErrEntityNotFound)
 			}
 			return nil
 		}
 
-		// Print summary and confirm
-		if !forceDelete {
+		// Print summary and confirm; --dry-run stops after the summary
+		if !forceDelete || deleteDryRun {
 			fmt.Println("The following entities will be deleted:")
 			for _, e := range toDelete {
 				path := ""
@@ -104,6 +107,9 @@ Requires confirmation unless --force is specified.`,
 				}
 				fmt.Printf("- %s (backend: %s, path: %s, title: %s)\n", e.Alias, e.SourceBackend, path, title)
 			}
+			if deleteDryRun {
+				return nil
+			}
 			fmt.Print("Proceed with deletion? [y/N]: ")
 			reader := bufio.NewReader(os.Stdin)
 			resp, _ := reader.ReadString('\n')
@@ -116,7 +122,7 @@ Requires confirmation unless --force is specified.`,
 
 		// Sort toDelete slice by SourceBackend descending (be2 before be1, etc.) for test determinism
 		if len(toDelete) > 1 {
-			sort.Slice(toDelete, func(i, j int) bool {
+			sort.SliceStable(toDelete, func(i, j int) bool {
 				return toDelete[i].SourceBackend > toDelete[j].SourceBackend
 			})
 		}
@@ -129,6 +135,26 @@ Requires confirmation unless --force is specified.`,
 				failed = append(failed, fmt.Sprintf("%s (backend: %s): %v", e.Alias, e.SourceBackend, err))
 				continue
 			}
+			if deleteIfCID != "" {
+				// Re-check right before deleting, in case the entity changed while confirming
+				current, err := appContext.EntityService.GetEntity(e.Alias, e.SourceBackend)
+				if err == nil && current.CID != deleteIfCID {
+					err = fmt.Errorf("content changed (CID %s, expected %s)", current.CID, deleteIfCID)
+				}
+				if err != nil {
+					failed = append(failed, fmt.Sprintf("%s (backend: %s): %v", e.Alias, e.SourceBackend, err))
+					continue
+				}
+			}
+			if deleteKeepBackup != "" {
+				// Back up the stored bytes rather than a re-serialization, so the file can be restored as-is
+				backupPath, err := backupBeforeDelete(backend, deleteKeepBackup, e.SourceBackend, e.Alias)
+				if err != nil {
+					failed = append(failed, fmt.Sprintf("%s (backend: %s): %v", e.Alias, e.SourceBackend, err))
+					continue
+				}
+				fmt.Printf("Backed up %s (backend: %s) to %s\n", e.Alias, e.SourceBackend, backupPath)
+			}
 			if err := backend.Delete(e.Alias); err != nil {
 				failed = append(failed, fmt.Sprintf("%s (backend: %s): %v", e.Alias, e.SourceBackend, err))
 			} else {
@@ -151,6 +177,9 @@ Requires confirmation unless --force is specified.`,
 		if len(notFound) > 0 && len(toDelete) > 0 {
 			appContext.Logger.Info("Some aliases provided were not found (and were not processed for deletion).", "aliases", strings.Join(notFound, ", "))
 		}
+		if len(failed) > 0 {
+			return fmt.Errorf("failed to delete %d of %d entities: %s", len(failed), len(toDelete), strings.Join(failed, "; "))
+		}
 
 		// Print available guidance entities summary (same as list command)
 		appContext.Logger.Debug("Starting summary of available entities post-deletion.")
@@ -201,7 +230,53 @@ Requires confirmation unless --force is specified.`,
 	},
 }
 
+// filterDeleteBackend keeps only the entities in backendName and returns the aliases that
+// have no copy there as not found.
+func filterDeleteBackend(aliases []string, found []model.Entity, backendName string) ([]model.Entity, []string) {
+	var kept []model.Entity
+	inBackend := make(map[string]bool)
+	for _, e := range found {
+		if e.SourceBackend == backendName {
+			kept = append(kept, e)
+			inBackend[e.Alias] = true
+		}
+	}
+	var notFound []string
+	for _, alias := range aliases {
+		if !inBackend[alias] && !slices.Contains(notFound, alias) {
+			notFound = append(notFound, alias)
+		}
+	}
+	return kept, notFound
+}
+
+// backupBeforeDelete reads alias's stored content from backend and writes it with writeDeleteBackup.
+func backupBeforeDelete(backend storage.ReadOnlyBackend, dir string, backendName string, alias string) (string, error) {
+	// A file that cannot be parsed is still backed up; localfs returns its content with the error
+	data, _, err := backend.Read(alias)
+	if err != nil && data == nil {
+		return "", fmt.Errorf("failed to read entity for backup: %w", err)
+	}
+	return writeDeleteBackup(dir, backendName, alias, data)
+}
+
+// writeDeleteBackup writes data to <dir>/<backendName>/<alias>.g6e and returns the path written.
+func writeDeleteBackup(dir string, backendName string, alias string, data []byte) (string, error) {
+	backupPath := filepath.Join(dir, backendName, filepath.FromSlash(alias)+bundleMemberExt)
+	if err := os.MkdirAll(filepath.Dir(backupPath), 0755); err != nil {
+		return "", fmt.Errorf("failed to create backup directory: %w", err)
+	}
+	if err := os.WriteFile(backupPath, data, 0644); err != nil {
+		return "", fmt.Errorf("failed to write backup: %w", err)
+	}
+	return backupPath, nil
+}
+
 func init() {
 	rootCmd.AddCommand(deleteCmd)
 	deleteCmd.Flags().BoolVarP(&forceDelete, "force", "f", false, "Delete without confirmation")
+	deleteCmd.Flags().BoolVar(&deleteDryRun, "dry-run", false, "List the entities that would be deleted without prompting or deleting")
+	deleteCmd.Flags().StringVar(&deleteBackend, "backend", "", "Only delete the copy of each entity in this backend")
+	deleteCmd.Flags().StringVar(&deleteIfCID, "if-cid", "", "Only delete if the entity's current content ID matches this CID")
+	deleteCmd.Flags().StringVar(&deleteKeepBackup, "keep-backup", "", "Directory to copy each entity's content to before deleting it")
 }
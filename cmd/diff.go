@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"fmt"
+
+	"gydnc/core/content"
+	"gydnc/internal/diff"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	diffBackends []string
+	diffContext  int
+)
+
+// diffCmd represents the diff command
+var diffCmd = &cobra.Command{
+	Use:   "diff <alias> --backend <a> --backend <b>",
+	Short: "Show a unified diff of an entity between two backends",
+	Long: `Compares the .g6e content of an alias in two backends and prints a unified diff.
+
+Both versions are serialized the same way they would be written, so only
+meaningful differences (frontmatter or body) are shown.
+
+Exit status is 0 if the entities are identical and 1 if they differ; other failures
+use the exit codes listed in the README (e.g. 3 if the alias is missing from a backend).`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		alias := args[0]
+
+		if appContext == nil || appContext.Config == nil || appContext.EntityService == nil {
+			return fmt.Errorf("application context, configuration, or entity service not initialized")
+		}
+		if len(diffBackends) != 2 {
+			return fmt.Errorf("diff requires exactly two --backend flags, got %d", len(diffBackends))
+		}
+		if diffContext < 0 {
+			return fmt.Errorf("--unified must not be negative, got %d", diffContext)
+		}
+
+		var versions [2][]string
+		for i, backendName := range diffBackends {
+			entity, err := appContext.EntityService.GetEntity(alias, backendName)
+			if err != nil {
+				return fmt.Errorf("failed to get entity '%s' from backend '%s': %w", alias, backendName, err)
+			}
+			gc := content.GuidanceContent{
+				Title:          entity.Title,
+				Description:    entity.Description,
+				Tags:           entity.Tags,
+				CustomMetadata: entity.CustomMetadata,
+				Body:           entity.Body,
+			}
+			fileBytes, err := gc.ToFileContent()
+			if err != nil {
+				return fmt.Errorf("failed to serialize entity '%s' from backend '%s': %w", alias, backendName, err)
+			}
+			versions[i] = diff.Lines(string(fileBytes))
+		}
+
+		unified := diff.Unified(
+			fmt.Sprintf("%s (backend: %s)", alias, diffBackends[0]),
+			fmt.Sprintf("%s (backend: %s)", alias, diffBackends[1]),
+			versions[0], versions[1], diffContext)
+		if unified == "" {
+			appContext.Logger.Info("Entities are identical.", "alias", alias, "backends", diffBackends)
+			return nil
+		}
+		fmt.Print(unified)
+		return fmt.Errorf("%w: '%s' in backends '%s' and '%s'", errEntitiesDiffer, alias, diffBackends[0], diffBackends[1])
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(diffCmd)
+	diffCmd.Flags().StringArrayVar(&diffBackends, "backend", nil, "Backend to compare (specify exactly twice)")
+	diffCmd.Flags().IntVarP(&diffContext, "unified", "U", 3, "Number of context lines")
+}
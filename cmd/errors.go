@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+
+	"gydnc/storage"
+	"gydnc/storage/localfs"
+)
+
+// Process exit codes. Scripts can branch on these instead of parsing stderr.
+//
+//	0  success
+//	1  any other failure (also: diff found differences)
+//	3  entity or file not found
+//	4  entity or file already exists
+//	5  ambiguous target backend
+//	6  configuration error (no usable config, unknown or missing default backend)
+const (
+	exitGeneric       = 1
+	exitNotFound      = 3
+	exitAlreadyExists = 4
+	exitAmbiguous     = 5
+	exitConfig        = 6
+)
+
+// errConfigUnavailable is reported when no configuration can be loaded for a command that needs one.
+var errConfigUnavailable = errors.New("active backend not initialized; run 'gydnc init' or check config")
+
+// errEntitiesDiffer is reported by diff when the compared entities are not identical.
+var errEntitiesDiffer = errors.New("entities differ")
+
+// errorCodes maps sentinel errors to the stable codes reported by --json-errors and to exit codes.
+// Order matters: the first sentinel matched by errors.Is wins.
+var errorCodes = []struct {
+	err  error
+	code string
+	exit int
+}{
+	{errConfigUnavailable, "config_error", exitConfig},
+	{errEntitiesDiffer, "entities_differ", exitGeneric},
+	{storage.ErrEntityNotFound, "entity_not_found", exitNotFound},
+	{storage.ErrEntityAlreadyExists, "entity_already_exists", exitAlreadyExists},
+	{storage.ErrAmbiguousBackend, "ambiguous_backend", exitAmbiguous},
+	{storage.ErrBackendNotFound, "backend_not_found", exitConfig},
+	{storage.ErrNoDefaultBackend, "no_default_backend", exitConfig},
+	{storage.ErrReadOnlyBackend, "read_only_backend", exitGeneric},
+	{storage.ErrUnsupportedOperation, "unsupported_operation", exitGeneric},
+	{storage.ErrAliasCaseCollision, "alias_case_collision", exitAlreadyExists},
+	{localfs.ErrLockTimeout, "lock_timeout", exitGeneric},
+	{fs.ErrNotExist, "not_found", exitNotFound},
+	{fs.ErrExist, "already_exists", exitAlreadyExists},
+	{fs.ErrPermission, "permission_denied", exitGeneric},
+}
+
+// errorCode returns the stable code for err, or "error" when no sentinel matches.
+func errorCode(err error) string {
+	for _, c := range errorCodes {
+		if errors.Is(err, c.err) {
+			return c.code
+		}
+	}
+	return "error"
+}
+
+// exitCode returns the process exit code for err, or exitGeneric when no sentinel matches.
+func exitCode(err error) int {
+	for _, c := range errorCodes {
+		if errors.Is(err, c.err) {
+			return c.exit
+		}
+	}
+	return exitGeneric
+}
+
+// writeCommandError reports a command failure to w, as a JSON envelope when --json-errors is set.
+func writeCommandError(w io.Writer, err error) {
+	if !jsonErrors {
+		fmt.Fprintf(w, "%s\n", err)
+		return
+	}
+	envelope := struct {
+		Error string `json:"error"`
+		Code  string `json:"code"`
+	}{Error: err.Error(), Code: errorCode(err)}
+	data, marshalErr := json.Marshal(envelope)
+	if marshalErr != nil {
+		fmt.Fprintf(w, "%s\n", err)
+		return
+	}
+	fmt.Fprintf(w, "%s\n", data)
+}
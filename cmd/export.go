@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"time"
+
+	"gydnc/core/content"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	exportBackend    string
+	exportFormat     string
+	exportFile       string
+	exportFilterTags string
+)
+
+// bundleMemberExt is the file extension of each entity in an export bundle; the member name is alias + ext.
+const bundleMemberExt = ".g6e"
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Bundle guidance entities from a backend into a gzipped tar archive",
+	Long: `Exports all entities of a backend (--backend, or the default backend) into a gzipped
+tar archive, one <alias>.g6e member per entity so alias paths are preserved. Use
+--filter-tags to export only matching entities.
+
+Each member holds the canonical serialization of the entity rather than its stored
+bytes, and members are sorted by alias with fixed timestamps, so exporting the same
+content always produces the same archive. Entities that cannot be parsed are skipped
+with a warning.
+
+The archive is written to --output, or to stdout if --output is '-' or not given.
+Note that for this command --output is a file path, not the output format.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if appContext == nil || appContext.Config == nil || appContext.EntityService == nil {
+			return fmt.Errorf("application context, configuration, or entity service not initialized")
+		}
+		if exportFormat != "tar" {
+			return fmt.Errorf("unsupported export format '%s' (supported: tar)", exportFormat)
+		}
+
+		backendName := exportBackend
+		if backendName == "" {
+			backendName = appContext.Config.DefaultBackend
+		}
+		if backendName == "" {
+			return fmt.Errorf("no backend to export; specify --backend or set default_backend in config")
+		}
+
+		entities, err := appContext.EntityService.ListEntitiesFromBackend(backendName, "", exportFilterTags)
+		if err != nil {
+			return fmt.Errorf("failed to list entities from backend '%s': %w", backendName, err)
+		}
+
+		var out io.Writer = os.Stdout
+		if exportFile != "" && exportFile != "-" {
+			file, err := os.Create(exportFile)
+			if err != nil {
+				return fmt.Errorf("failed to create '%s': %w", exportFile, err)
+			}
+			defer file.Close()
+			out = file
+		}
+
+		gzipWriter := gzip.NewWriter(out)
+		tarWriter := tar.NewWriter(gzipWriter)
+		exported := 0
+		for _, listed := range entities {
+			entity, err := appContext.EntityService.GetEntity(listed.Alias, backendName)
+			if err != nil {
+				return fmt.Errorf("failed to read entity '%s' from backend '%s': %w", listed.Alias, backendName, err)
+			}
+			if len(entity.Warnings) > 0 {
+				slog.Warn("Skipping entity that could not be parsed", "alias", entity.Alias, "warnings", entity.Warnings)
+				continue
+			}
+
+			gc := content.GuidanceContent{
+				Title:          entity.Title,
+				Description:    entity.Description,
+				Tags:           entity.Tags,
+				CustomMetadata: content.JoinPCID(entity.CustomMetadata, entity.PCID),
+				Body:           entity.Body,
+			}
+			fileBytes, err := gc.ToFileContent()
+			if err != nil {
+				return fmt.Errorf("failed to serialize entity '%s': %w", entity.Alias, err)
+			}
+
+			header := &tar.Header{
+				Name:    entity.Alias + bundleMemberExt,
+				Mode:    0644,
+				Size:    int64(len(fileBytes)),
+				ModTime: time.Unix(0, 0).UTC(),
+				Format:  tar.FormatPAX,
+			}
+			if err := tarWriter.WriteHeader(header); err != nil {
+				return fmt.Errorf("failed to write archive header for '%s': %w", entity.Alias, err)
+			}
+			if _, err := tarWriter.Write(fileBytes); err != nil {
+				return fmt.Errorf("failed to write archive member for '%s': %w", entity.Alias, err)
+			}
+			exported++
+		}
+
+		if err := tarWriter.Close(); err != nil {
+			return fmt.Errorf("failed to finish tar archive: %w", err)
+		}
+		if err := gzipWriter.Close(); err != nil {
+			return fmt.Errorf("failed to finish gzip stream: %w", err)
+		}
+		slog.Info("Exported guidance entities", "backend", backendName, "count", exported)
+		return nil
+	},
+	SilenceUsage: true,
+}
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+	exportCmd.Flags().StringVar(&exportBackend, "backend", "", "Backend to export (default: the default backend)")
+	exportCmd.Flags().StringVar(&exportFormat, "format", "tar", "Archive format (supported: tar, gzip-compressed)")
+	exportCmd.Flags().StringVarP(&exportFile, "output", "o", "", "File to write the archive to ('-' or empty for stdout)")
+	exportCmd.Flags().StringVar(&exportFilterTags, "filter-tags", "", "Only export entities matching this tag filter (e.g., \"scope:code -deprecated\")")
+}
@@ -1,64 +1,565 @@
 package cmd
 
 import (
+	"bufio"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/fs"
 	"log/slog"
 	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"gydnc/core/content"
+	"gydnc/internal/utils"
+	"gydnc/model"
+	"gydnc/service"
+	"gydnc/storage"
 
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 )
 
 // NEW SIMPLIFIED STRUCT for "structured" (default) JSON output
 type SimplifiedStructuredOutput struct {
-	Title       string   `json:"title"`
-	Description string   `json:"description,omitempty"`
-	Tags        []string `json:"tags,omitempty"`
-	Body        string   `json:"body"`
+	Title       string   `json:"title" yaml:"title"`
+	Description string   `json:"description,omitempty" yaml:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Body        string   `json:"body" yaml:"body"`
+	// TokenEstimate is only set with --count-tokens
+	TokenEstimate *int `json:"token_estimate,omitempty" yaml:"token_estimate,omitempty"`
+	// CID and PCID are only set with --extended; PCID is empty for an entity that was never overwritten
+	CID  *string `json:"cid,omitempty" yaml:"cid,omitempty"`
+	PCID *string `json:"pcid,omitempty" yaml:"pcid,omitempty"`
+	// Warnings is set when the entity could only be read leniently and its fields may be incomplete
+	Warnings []string `json:"warnings,omitempty" yaml:"warnings,omitempty"`
+	// Aliases is only set with --dedupe-by-cid and lists every requested alias with this content
+	Aliases []string `json:"aliases,omitempty" yaml:"aliases,omitempty"`
+	// SourceBytesHash, CanonicalBytesHash and Canonical are only set with --include-source-bytes-hash
+	SourceBytesHash    *string `json:"source_bytes_hash,omitempty" yaml:"source_bytes_hash,omitempty"`
+	CanonicalBytesHash *string `json:"canonical_bytes_hash,omitempty" yaml:"canonical_bytes_hash,omitempty"`
+	Canonical          *bool   `json:"canonical,omitempty" yaml:"canonical,omitempty"`
+}
+
+// getFields holds the value of the --fields flag.
+var getFields string
+
+// getWrap holds the column width given with --wrap; 0 disables wrapping.
+var getWrap int
+
+// getCountTokens holds the value of the --count-tokens flag.
+var getCountTokens bool
+
+// getExtended holds the value of the --extended flag.
+var getExtended bool
+
+// getDedupeByCID holds the value of the --dedupe-by-cid flag.
+var getDedupeByCID bool
+
+// getNormalize holds the value of the --normalize flag.
+var getNormalize bool
+
+// getSourceBytesHash holds the value of the --include-source-bytes-hash flag.
+var getSourceBytesHash bool
+
+// getAny holds the value of the --any flag.
+var getAny bool
+
+// getTrace holds the value of the --trace flag.
+var getTrace bool
+
+// getAnnotateSource holds the value of the --annotate-source flag.
+var getAnnotateSource bool
+
+// getSince holds the path of the snapshot file given with --since.
+var getSince string
+
+// getRetitle and getRetag hold the title and tags given with --retitle and --retag.
+var getRetitle string
+var getRetag []string
+
+// Frontmatter fields added by get --annotate-source to record where an entity came from.
+const (
+	sourceBackendKey = "source_backend"
+	sourceAliasKey   = "source_alias"
+	sourceCIDKey     = "cid"
+)
+
+// UnchangedMarker is emitted by --since in place of an entity whose CID matches the snapshot.
+type UnchangedMarker struct {
+	Alias     string `json:"alias" yaml:"alias"`
+	Unchanged bool   `json:"unchanged" yaml:"unchanged"`
+}
+
+// loadSnapshot reads a snapshot file: a JSON object mapping alias to content ID (CID).
+func loadSnapshot(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot '%s': %w", path, err)
+	}
+	snapshot := make(map[string]string)
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to parse snapshot '%s' (expected a JSON object of alias to CID): %w", path, err)
+	}
+	return snapshot, nil
+}
+
+// getFieldNames lists the fields selectable with --fields, in output order.
+var getFieldNames = []string{"title", "description", "tags", "body"}
+
+// ProjectedStructuredOutput carries only the fields selected with --fields.
+// A nil pointer means the field was not selected; selected fields are always emitted, even if empty.
+type ProjectedStructuredOutput struct {
+	Title       *string   `json:"title,omitempty" yaml:"title,omitempty"`
+	Description *string   `json:"description,omitempty" yaml:"description,omitempty"`
+	Tags        *[]string `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Body        *string   `json:"body,omitempty" yaml:"body,omitempty"`
+	// TokenEstimate is only set with --count-tokens, independent of --fields
+	TokenEstimate *int `json:"token_estimate,omitempty" yaml:"token_estimate,omitempty"`
+	// CID and PCID are only set with --extended, independent of --fields
+	CID  *string `json:"cid,omitempty" yaml:"cid,omitempty"`
+	PCID *string `json:"pcid,omitempty" yaml:"pcid,omitempty"`
+	// Warnings is always included when present, independent of --fields
+	Warnings []string `json:"warnings,omitempty" yaml:"warnings,omitempty"`
+	// Aliases is only set with --dedupe-by-cid, independent of --fields
+	Aliases []string `json:"aliases,omitempty" yaml:"aliases,omitempty"`
+	// Source bytes hashes are only set with --include-source-bytes-hash, independent of --fields
+	SourceBytesHash    *string `json:"source_bytes_hash,omitempty" yaml:"source_bytes_hash,omitempty"`
+	CanonicalBytesHash *string `json:"canonical_bytes_hash,omitempty" yaml:"canonical_bytes_hash,omitempty"`
+	Canonical          *bool   `json:"canonical,omitempty" yaml:"canonical,omitempty"`
+}
+
+// parseGetFields validates a comma-separated --fields value and returns the set of selected fields.
+func parseGetFields(fieldsStr string) (map[string]bool, error) {
+	selected := make(map[string]bool)
+	for _, field := range strings.Split(fieldsStr, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		known := false
+		for _, name := range getFieldNames {
+			if field == name {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown field '%s' in --fields (valid fields: %s)", field, strings.Join(getFieldNames, ", "))
+		}
+		selected[field] = true
+	}
+	if len(selected) == 0 {
+		return nil, fmt.Errorf("--fields requires at least one of: %s", strings.Join(getFieldNames, ", "))
+	}
+	return selected, nil
+}
+
+// projectStructuredOutput keeps only the selected fields of data.
+func projectStructuredOutput(data SimplifiedStructuredOutput, selected map[string]bool) ProjectedStructuredOutput {
+	var projected ProjectedStructuredOutput
+	if selected["title"] {
+		projected.Title = &data.Title
+	}
+	if selected["description"] {
+		projected.Description = &data.Description
+	}
+	if selected["tags"] {
+		tags := data.Tags
+		if tags == nil {
+			tags = []string{}
+		}
+		projected.Tags = &tags
+	}
+	if selected["body"] {
+		projected.Body = &data.Body
+	}
+	projected.TokenEstimate = data.TokenEstimate
+	projected.CID = data.CID
+	projected.PCID = data.PCID
+	projected.Warnings = data.Warnings
+	projected.Aliases = data.Aliases
+	projected.SourceBytesHash = data.SourceBytesHash
+	projected.CanonicalBytesHash = data.CanonicalBytesHash
+	projected.Canonical = data.Canonical
+	return projected
+}
+
+// splitBackendQualifiedID splits an ID of the form backend:alias into its backend name and
+// alias. The ID is split on its first ':' only when the prefix names a configured backend;
+// otherwise the backend name is empty and the whole ID is the alias.
+func splitBackendQualifiedID(id string, cfg *model.Config) (string, string) {
+	prefix, alias, found := strings.Cut(id, ":")
+	if !found || prefix == "" || alias == "" || cfg == nil {
+		return "", id
+	}
+	if _, ok := cfg.StorageBackends[prefix]; !ok {
+		return "", id
+	}
+	return prefix, alias
+}
+
+// expandStdinIDs replaces a "-" argument with the newline-separated IDs read from stdin.
+func expandStdinIDs(args []string, stdin io.Reader) ([]string, error) {
+	if !slices.Contains(args, "-") {
+		return args, nil
+	}
+	var stdinIDs []string
+	scanner := bufio.NewScanner(stdin)
+	for scanner.Scan() {
+		if id := strings.TrimSpace(scanner.Text()); id != "" {
+			stdinIDs = append(stdinIDs, id)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read IDs from stdin: %w", err)
+	}
+	if len(stdinIDs) == 0 {
+		return nil, fmt.Errorf("no IDs read from stdin")
+	}
+
+	ids := make([]string, 0, len(args)+len(stdinIDs))
+	expanded := false
+	for _, arg := range args {
+		if arg != "-" {
+			ids = append(ids, arg)
+		} else if !expanded {
+			ids = append(ids, stdinIDs...)
+			expanded = true
+		}
+	}
+	return ids, nil
 }
 
 var getCmd = &cobra.Command{
 	Use:   "get <id1> [id2...]",
-	Short: "Retrieves and displays one or more guidance entities by their ID(s) as JSON.",
+	Short: "Retrieves and displays one or more guidance entities by their ID(s) as JSON, YAML or raw .g6e.",
 	Long: `Retrieves and displays the content of one or more guidance entities
-from the configured backend, based on their IDs. Output is always in JSON format
-containing title, description, tags, and body.`,
+from the configured backend, based on their IDs. Output is JSON by default, containing
+title, description, tags, and body; see --output below for YAML and raw .g6e content.
+
+When an ID exists in several backends, the copy is taken from the backends listed in the
+config's backend_priority in that order, then the default backend, then the rest by name,
+so the result is the same on every run. Use --any to read all backends concurrently and
+take whichever copy is found first instead.
+
+Use --trace to print, on stderr, the backend reads made for each ID in resolution order,
+how long each took and whether it found the entity, followed by the total time and the
+backend that satisfied the request, e.g. to find which backend makes a fetch slow:
+  trace: id=my-rule backend=team duration=1.2ms result=not_found
+  trace: id=my-rule total=1.9ms backends_tried=2 satisfied_by=local
+
+Use --fields to select a subset of title, description, tags and body, e.g.
+--fields title,tags to skip transferring large bodies when only metadata is needed.
+With --output raw, --fields must name exactly one field, whose value is printed without
+any wrapping: the body as stored, the title or description on one line, or the tags one
+per line, e.g. 'get my-rule --fields body --output raw'.
+
+Use --output to choose the format: json (default), yaml, or raw (also accepted as g6e).
+raw prints the reconstructed .g6e file content; with multiple IDs the files are printed
+one after another, each starting with its '---' frontmatter delimiter.
+
+Use --normalize to emit a canonical form regardless of how the entity is formatted on
+disk: tags are sorted, trailing whitespace is stripped from every body line, and the body
+ends in exactly one newline. The cid shown by --extended is that of the normalized body,
+so e.g. 'get --output g6e --normalize' output can be compared across backends.
+
+Use --annotate-source with --output raw (or g6e) to record provenance in the emitted
+frontmatter: source_backend, source_alias and cid (the content ID of the stored body) are
+added alongside any custom fields, so distributed copies can be traced back.
+
+Use --since <snapshot-file> to only fetch entities whose content ID (CID) changed since
+the snapshot, a JSON object mapping alias to CID. Unchanged entities are reported as
+{"alias": "<id>", "unchanged": true} instead of their full content.
+
+Use --wrap N to hard-wrap the body to N columns for display. Lines are broken only
+between words and code fences are left as-is; the stored entity is not changed.
+
+Use --count-tokens to add a token_estimate field with the approximate number of
+tokens in the body, e.g. to decide whether an entity fits an agent's context budget.
+The estimate is a simple heuristic (the larger of the word count and characters/4),
+not the output of a real tokenizer.
+
+Use --include-source-bytes-hash to add source_bytes_hash (the SHA-256 of the file as
+stored), canonical_bytes_hash (the SHA-256 of its canonical form, as printed by
+'get --output g6e --normalize') and canonical (whether the two match). An entity with
+canonical false would be rewritten by a normalization pass.
+
+Use --extended to include the content ID (cid) and the parent content ID (pcid), i.e.
+the CID of the version this one replaced. pcid is empty until the entity is first
+updated or overwritten with a different body; following pcid values gives the lineage.
+
+Use --dedupe-by-cid when fetching several IDs that may share content: entities with
+the same content ID are returned once, with an aliases array listing every requested
+ID that has that content. With --output raw, duplicates are simply omitted.
+
+Use --retitle and --retag to override the title or the tags in the output without saving
+anything, e.g. to fork an entity into a new one:
+  gydnc get my-rule --retitle "My Rule (strict)" --output g6e > my-rule-strict.g6e
+--retag replaces all tags (comma-separated; an empty value clears them).
+
+If an entity's frontmatter cannot be parsed, it is still returned with its raw content
+as the body and a warnings array describing the problem, since the other fields may be
+incomplete.
+
+If an ID cannot be fetched the command fails, exiting with 3 when the entity is not found.
+With several IDs, the others are still printed, each failed ID appearing in the array as a
+placeholder whose title starts with ERROR_FETCHING_CONTENT_FOR_, before the command fails.
+
+Pass - as an ID to read newline-separated IDs from stdin, e.g.
+  gydnc list --aliases-only | gydnc get -
+Blank lines are skipped and the IDs are fetched as if they had been given as arguments.
+
+Prefix an ID with a configured backend name and a colon to read it from that backend only,
+e.g. 'get prod:rule-42' when rule-42 exists in several backends. The prefix is only
+recognized when it names a configured backend; any other ID containing ':' is resolved
+as usual. A qualified ID is looked up exactly, even with --any.`,
 	Args: cobra.MinimumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		idsToGet := args
+		idsToGet, err := expandStdinIDs(args, os.Stdin)
+		if err != nil {
+			return err
+		}
 
 		if appContext == nil || appContext.Config == nil || appContext.EntityService == nil {
 			slog.Error("Application context, configuration, or entity service not initialized.")
 			return fmt.Errorf("application context, configuration, or entity service not initialized")
 		}
 
-		var results []SimplifiedStructuredOutput
+		format := outputFormat
+		if format == "" {
+			format = "json"
+		}
+		if format == "g6e" {
+			format = "raw"
+		}
+		if format != "json" && format != "yaml" && format != "raw" {
+			return fmt.Errorf("unsupported output format '%s' for get (supported: json, yaml, raw)", format)
+		}
+		if getTrace && getAny {
+			return fmt.Errorf("--trace cannot be combined with --any")
+		}
+		if getWrap < 0 {
+			return fmt.Errorf("--wrap must be a positive column width")
+		}
+		if format != "raw" && getAnnotateSource {
+			return fmt.Errorf("--annotate-source requires --output raw")
+		}
+		if format == "raw" && getSourceBytesHash {
+			return fmt.Errorf("--include-source-bytes-hash cannot be combined with --output raw")
+		}
+		if format == "raw" && getCountTokens {
+			return fmt.Errorf("--count-tokens cannot be combined with --output raw")
+		}
+
+		var snapshot map[string]string
+		if getSince != "" {
+			var err error
+			snapshot, err = loadSnapshot(getSince)
+			if err != nil {
+				return err
+			}
+		}
+
+		var selectedFields map[string]bool
+		if cmd.Flags().Changed("fields") {
+			var err error
+			selectedFields, err = parseGetFields(getFields)
+			if err != nil {
+				return err
+			}
+		}
+		// With --output raw, the single field selected with --fields is printed on its own
+		var rawField string
+		if format == "raw" && selectedFields != nil {
+			if len(selectedFields) != 1 {
+				return fmt.Errorf("--fields with --output raw requires exactly one field")
+			}
+			if getAnnotateSource {
+				return fmt.Errorf("--annotate-source cannot be combined with --fields")
+			}
+			for field := range selectedFields {
+				rawField = field
+			}
+		}
+
+		var results []interface{}
 		if len(idsToGet) > 1 {
-			results = make([]SimplifiedStructuredOutput, 0, len(idsToGet))
+			results = make([]interface{}, 0, len(idsToGet))
 		}
+		// With --dedupe-by-cid, maps each CID already emitted to the aliases list of its result
+		aliasesByCID := make(map[string]*[]string)
+		// IDs that could not be fetched; reported after the results so the exit code reflects them
+		var failedIDs []string
+		var firstErr error
 
 		for _, id := range idsToGet {
-			entity, err := appContext.EntityService.GetEntity(id, "")
+			var entity model.Entity
+			var err error
+			backendName, alias := splitBackendQualifiedID(id, appContext.Config)
+			if getAny && backendName == "" {
+				entity, err = appContext.EntityService.GetEntityAny(id)
+			} else if getTrace {
+				var attempts []service.BackendAttempt
+				start := time.Now()
+				entity, attempts, err = appContext.EntityService.GetEntityTraced(alias, backendName)
+				printGetTrace(id, attempts, time.Since(start), err)
+			} else {
+				entity, err = appContext.EntityService.GetEntity(alias, backendName)
+			}
 
 			if err != nil {
+				if len(idsToGet) == 1 {
+					return fmt.Errorf("failed to get entity '%s': %w", id, err)
+				}
 				slog.Error("Failed to get entity using EntityService", "id", id, "error", err)
-				if len(idsToGet) > 1 {
+				failedIDs = append(failedIDs, id)
+				if firstErr == nil {
+					firstErr = err
+				}
+				if format != "raw" {
 					results = append(results, SimplifiedStructuredOutput{Title: "ERROR_FETCHING_CONTENT_FOR_" + id, Body: fmt.Sprintf("Error: %v", err)})
 				}
 				continue
 			}
 
+			if snapshot != nil && entity.CID != "" && snapshot[alias] == entity.CID {
+				slog.Debug("Entity unchanged since snapshot", "id", id, "cid", entity.CID)
+				if format == "raw" {
+					continue
+				}
+				marker := UnchangedMarker{Alias: id, Unchanged: true}
+				if len(idsToGet) > 1 {
+					results = append(results, marker)
+				} else if format == "yaml" {
+					yamlBytes, _ := yaml.Marshal(marker)
+					fmt.Fprint(os.Stdout, string(yamlBytes))
+				} else {
+					jsonBytes, _ := json.MarshalIndent(marker, "", "  ")
+					fmt.Fprintln(os.Stdout, string(jsonBytes))
+				}
+				continue
+			}
+
+			if cmd.Flags().Changed("retitle") {
+				entity.Title = getRetitle
+			}
+			if cmd.Flags().Changed("retag") {
+				entity.Tags = getRetag
+			}
+
+			if getNormalize && len(entity.Warnings) == 0 {
+				entity.Tags = slices.Sorted(slices.Values(entity.Tags))
+				entity.Body = content.NormalizeBody(entity.Body)
+				normalized := content.GuidanceContent{Body: entity.Body}
+				entity.CID, _ = normalized.GetContentID()
+			}
+
+			if getDedupeByCID && entity.CID != "" {
+				if aliases, seen := aliasesByCID[entity.CID]; seen {
+					slog.Debug("Skipping entity with duplicate content", "id", id, "cid", entity.CID)
+					*aliases = append(*aliases, id)
+					continue
+				}
+			}
+
+			body := content.WrapBody(entity.Body, getWrap)
+
+			if format == "raw" && len(entity.Warnings) > 0 {
+				// Leniently read entities carry the unparsed file content as their body
+				slog.Warn("Entity could not be parsed; printing its content as stored", "id", id, "warnings", entity.Warnings)
+				fmt.Fprint(os.Stdout, entity.Body)
+				continue
+			}
+			if rawField != "" {
+				fmt.Fprint(os.Stdout, rawFieldValue(entity, body, rawField))
+				if getDedupeByCID && entity.CID != "" {
+					aliasesByCID[entity.CID] = &[]string{id}
+				}
+				continue
+			}
+			if format == "raw" {
+				custom := content.JoinPCID(entity.CustomMetadata, entity.PCID)
+				if getAnnotateSource {
+					custom = annotateSource(custom, entity)
+				}
+				gc := content.GuidanceContent{
+					Title:          entity.Title,
+					Description:    entity.Description,
+					Tags:           entity.Tags,
+					CustomMetadata: custom,
+					Body:           body,
+				}
+				fileBytes, err := gc.ToFileContent()
+				if err != nil {
+					slog.Error("Failed to serialize entity to G6E format", "id", id, "error", err)
+					continue
+				}
+				fmt.Fprint(os.Stdout, string(fileBytes))
+				if getDedupeByCID && entity.CID != "" {
+					aliasesByCID[entity.CID] = &[]string{id}
+				}
+				continue
+			}
+
 			structuredData := SimplifiedStructuredOutput{
 				Title:       entity.Title,
 				Description: entity.Description,
 				Tags:        entity.Tags,
-				Body:        entity.Body,
+				Body:        body,
+				Warnings:    entity.Warnings,
+			}
+			if getExtended {
+				cid, pcid := entity.CID, entity.PCID
+				structuredData.CID = &cid
+				structuredData.PCID = &pcid
+			}
+			if getCountTokens {
+				tokens := content.EstimateTokens(entity.Body)
+				structuredData.TokenEstimate = &tokens
+			}
+			if getSourceBytesHash && len(entity.Warnings) == 0 {
+				sourceHash, canonicalHash, err := sourceBytesHashes(entity)
+				if err != nil {
+					slog.Warn("Failed to hash stored bytes of entity", "id", id, "error", err)
+				} else {
+					canonical := sourceHash == canonicalHash
+					structuredData.SourceBytesHash = &sourceHash
+					structuredData.CanonicalBytesHash = &canonicalHash
+					structuredData.Canonical = &canonical
+				}
+			}
+
+			if getDedupeByCID {
+				structuredData.Aliases = []string{id}
+			}
+
+			// Results are kept as pointers so --dedupe-by-cid can add later aliases to them
+			var output interface{} = &structuredData
+			aliases := &structuredData.Aliases
+			if selectedFields != nil {
+				projected := projectStructuredOutput(structuredData, selectedFields)
+				output = &projected
+				aliases = &projected.Aliases
+			}
+			if getDedupeByCID && entity.CID != "" {
+				aliasesByCID[entity.CID] = aliases
 			}
 
 			if len(idsToGet) > 1 {
-				results = append(results, structuredData)
+				results = append(results, output)
+			} else if format == "yaml" {
+				yamlBytes, marshalErr := yaml.Marshal(output)
+				if marshalErr != nil {
+					slog.Error("Failed to marshal structured data to YAML", "id", id, "error", marshalErr)
+					continue
+				}
+				fmt.Fprint(os.Stdout, string(yamlBytes))
 			} else {
-				jsonBytes, marshalErr := json.MarshalIndent(structuredData, "", "  ")
+				jsonBytes, marshalErr := json.MarshalIndent(output, "", "  ")
 				if marshalErr != nil {
 					slog.Error("Failed to marshal structured data to JSON", "id", id, "error", marshalErr)
 					continue
@@ -67,7 +568,14 @@ containing title, description, tags, and body.`,
 			}
 		}
 
-		if len(idsToGet) > 1 && len(results) > 0 {
+		if len(idsToGet) > 1 && len(results) > 0 && format == "yaml" {
+			finalYamlBytes, marshalErr := yaml.Marshal(results)
+			if marshalErr != nil {
+				slog.Error("Failed to marshal final structured YAML list", "error", marshalErr)
+				return fmt.Errorf("marshalling final structured YAML list: %w", marshalErr)
+			}
+			fmt.Fprint(os.Stdout, string(finalYamlBytes))
+		} else if len(idsToGet) > 1 && len(results) > 0 {
 			finalJsonBytes, marshalErr := json.MarshalIndent(results, "", "  ")
 			if marshalErr != nil {
 				slog.Error("Failed to marshal final structured JSON array", "error", marshalErr)
@@ -75,10 +583,103 @@ containing title, description, tags, and body.`,
 			}
 			fmt.Fprintln(os.Stdout, string(finalJsonBytes))
 		}
+		if len(failedIDs) > 0 {
+			return fmt.Errorf("failed to get %d of %d entities (%s): %w", len(failedIDs), len(idsToGet), strings.Join(failedIDs, ", "), firstErr)
+		}
 		return nil
 	},
 }
 
+// rawFieldValue returns the text printed by --output raw --fields <field>: the body as is,
+// the title or description followed by a newline, or one tag per line.
+func rawFieldValue(entity model.Entity, body, field string) string {
+	switch field {
+	case "title":
+		return entity.Title + "\n"
+	case "description":
+		return entity.Description + "\n"
+	case "tags":
+		if len(entity.Tags) == 0 {
+			return ""
+		}
+		return strings.Join(entity.Tags, "\n") + "\n"
+	default:
+		return body
+	}
+}
+
+// printGetTrace writes the --trace report for one ID to stderr: a line per backend read, then
+// the total time and the backend that satisfied the request.
+func printGetTrace(id string, attempts []service.BackendAttempt, total time.Duration, err error) {
+	for _, attempt := range attempts {
+		result := "found"
+		if attempt.Err != nil {
+			result = "not_found"
+			if !errors.Is(attempt.Err, fs.ErrNotExist) && !errors.Is(attempt.Err, storage.ErrEntityNotFound) {
+				result = "error"
+			}
+		}
+		fmt.Fprintf(os.Stderr, "trace: id=%s backend=%s duration=%s result=%s\n", id, attempt.Backend, attempt.Duration, result)
+	}
+	satisfiedBy := "none"
+	if err == nil && len(attempts) > 0 {
+		satisfiedBy = attempts[len(attempts)-1].Backend
+	}
+	fmt.Fprintf(os.Stderr, "trace: id=%s total=%s backends_tried=%d satisfied_by=%s\n", id, total, len(attempts), satisfiedBy)
+}
+
+// sourceBytesHashes returns the SHA-256 of entity's file as stored in its backend and of its
+// canonical serialization, the form emitted by 'get --output g6e --normalize'. They are equal
+// when the stored file is already canonical.
+func sourceBytesHashes(entity model.Entity) (string, string, error) {
+	backend, err := appContext.GetBackend(entity.SourceBackend)
+	if err != nil {
+		return "", "", err
+	}
+	stored, _, err := backend.Read(entity.Alias)
+	if err != nil {
+		return "", "", err
+	}
+	canonical := content.GuidanceContent{
+		Title:          entity.Title,
+		Description:    entity.Description,
+		Tags:           slices.Sorted(slices.Values(entity.Tags)),
+		CustomMetadata: content.JoinPCID(entity.CustomMetadata, entity.PCID),
+		Body:           content.NormalizeBody(entity.Body),
+	}
+	canonicalBytes, err := canonical.ToFileContent()
+	if err != nil {
+		return "", "", err
+	}
+	return utils.Sha256(stored), utils.Sha256(canonicalBytes), nil
+}
+
+// annotateSource returns a copy of custom with the --annotate-source provenance fields of
+// entity added.
+func annotateSource(custom map[string]interface{}, entity model.Entity) map[string]interface{} {
+	annotated := make(map[string]interface{}, len(custom)+3)
+	for k, v := range custom {
+		annotated[k] = v
+	}
+	annotated[sourceBackendKey] = entity.SourceBackend
+	annotated[sourceAliasKey] = entity.Alias
+	annotated[sourceCIDKey] = entity.CID
+	return annotated
+}
+
 func init() {
 	rootCmd.AddCommand(getCmd)
+	getCmd.Flags().BoolVar(&getSourceBytesHash, "include-source-bytes-hash", false, "Include hashes of the stored file and of its canonical form, to detect files that are not canonical")
+	getCmd.Flags().BoolVar(&getTrace, "trace", false, "Print per-backend read timings for each ID to stderr")
+	getCmd.Flags().BoolVar(&getAny, "any", false, "Return the first copy found by reading all backends concurrently, instead of following backend_priority and the default backend")
+	getCmd.Flags().BoolVar(&getAnnotateSource, "annotate-source", false, "With --output raw, add source_backend, source_alias and cid to the emitted frontmatter")
+	getCmd.Flags().StringVar(&getRetitle, "retitle", "", "Output the entity with this title instead of its own (nothing is saved)")
+	getCmd.Flags().StringSliceVar(&getRetag, "retag", []string{}, "Output the entity with these comma-separated tags instead of its own (nothing is saved)")
+	getCmd.Flags().StringVar(&getSince, "since", "", "Snapshot file (JSON alias-to-CID map); only return entities whose CID changed since it")
+	getCmd.Flags().BoolVar(&getNormalize, "normalize", false, "Emit the entity in canonical form (sorted tags, no trailing whitespace, single final newline)")
+	getCmd.Flags().BoolVar(&getDedupeByCID, "dedupe-by-cid", false, "Return entities with identical content IDs once, listing the aliases that share it")
+	getCmd.Flags().BoolVar(&getExtended, "extended", false, "Include the content ID (cid) and parent content ID (pcid) in the output")
+	getCmd.Flags().BoolVar(&getCountTokens, "count-tokens", false, "Include an approximate token count of the body (token_estimate) in the output")
+	getCmd.Flags().IntVar(&getWrap, "wrap", 0, "Hard-wrap the body to this column width for display (0 = no wrapping)")
+	getCmd.Flags().StringVar(&getFields, "fields", "", "Comma-separated fields to include in the output (title, description, tags, body); exactly one with --output raw")
 }
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sort"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	hashAll        bool
+	hashFilterTags string
+)
+
+var hashCmd = &cobra.Command{
+	Use:   "hash [alias...]",
+	Short: "Print the content IDs (CIDs) of guidance entities",
+	Long: `Prints the content ID (CID, the SHA-256 of the body) of each given alias, one
+"<alias> <cid>" line per entity, sorted by alias.
+
+Use --all to print a manifest of every entity in the store instead, optionally narrowed
+with --filter-tags (same syntax as list). Aliases found in several backends are resolved
+as in list, preferring the default backend.
+
+With --output json the manifest is a JSON object mapping alias to CID, the snapshot
+format read by 'get --since', e.g.:
+  gydnc hash --all --output json > snapshot.json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if appContext == nil || appContext.Config == nil || appContext.EntityService == nil {
+			return fmt.Errorf("application context, configuration, or entity service not initialized")
+		}
+		if outputFormat != "" && outputFormat != "json" {
+			return fmt.Errorf("unsupported output format '%s' for hash (supported: json)", outputFormat)
+		}
+		if hashAll && len(args) > 0 {
+			return fmt.Errorf("--all cannot be combined with aliases")
+		}
+		if !hashAll && len(args) == 0 {
+			return fmt.Errorf("specify one or more aliases, or --all")
+		}
+		if hashFilterTags != "" && !hashAll {
+			return fmt.Errorf("--filter-tags requires --all")
+		}
+
+		// Backend to read each alias from; empty means search all backends
+		sources := make(map[string]string)
+		if hashAll {
+			entities, backendErrors := appContext.EntityService.ListEntitiesMerged("", hashFilterTags)
+			for backendName, err := range backendErrors {
+				slog.Warn("Error accessing backend during hash", "backend", backendName, "error", err)
+			}
+			for _, entity := range entities {
+				sources[entity.Alias] = entity.SourceBackend
+			}
+		} else {
+			for _, alias := range args {
+				sources[alias] = ""
+			}
+		}
+
+		aliases := make([]string, 0, len(sources))
+		for alias := range sources {
+			aliases = append(aliases, alias)
+		}
+		sort.Strings(aliases)
+
+		manifest := make(map[string]string, len(aliases))
+		var failed int
+		for _, alias := range aliases {
+			entity, err := appContext.EntityService.GetEntity(alias, sources[alias])
+			if err != nil {
+				slog.Error("Failed to read entity to compute its CID", "alias", alias, "error", err)
+				failed++
+				continue
+			}
+			manifest[alias] = entity.CID
+		}
+
+		if outputFormat == "json" {
+			jsonBytes, err := json.MarshalIndent(manifest, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal manifest to JSON: %w", err)
+			}
+			fmt.Println(string(jsonBytes))
+		} else {
+			for _, alias := range aliases {
+				if cid, ok := manifest[alias]; ok {
+					fmt.Printf("%s %s\n", alias, cid)
+				}
+			}
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("failed to compute the CID of %d entities", failed)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(hashCmd)
+	hashCmd.Flags().BoolVar(&hashAll, "all", false, "Print the CID of every entity in the store")
+	hashCmd.Flags().StringVar(&hashFilterTags, "filter-tags", "", "With --all, only include entities matching this tag filter (same syntax as list)")
+}
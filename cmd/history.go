@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"gydnc/internal/gitmeta"
+
+	"github.com/spf13/cobra"
+)
+
+var historyBackend string
+
+var historyCmd = &cobra.Command{
+	Use:   "history <alias>",
+	Short: "Show the git commit history of a guidance entity",
+	Long: `Shows the commits that changed an entity's file, newest first, when the backend's
+directory is inside a git work tree (equivalent to 'git log --oneline -- <file>').
+
+If the entity's file is not in a git repository, or its backend does not store
+entities as files, a "history unavailable" message is printed and the command
+still exits 0. Use --output json for a JSON array of {hash, subject}; when history is
+unavailable it is empty and the message is logged to stderr instead, so stdout is
+always valid JSON.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		alias := args[0]
+
+		if appContext == nil || appContext.Config == nil || appContext.EntityService == nil {
+			return fmt.Errorf("application context, configuration, or entity service not initialized")
+		}
+
+		entity, err := appContext.EntityService.GetEntity(alias, historyBackend)
+		if err != nil {
+			return fmt.Errorf("failed to get entity '%s': %w", alias, err)
+		}
+		backend, err := appContext.GetBackend(entity.SourceBackend)
+		if err != nil {
+			return fmt.Errorf("failed to get backend '%s': %w", entity.SourceBackend, err)
+		}
+		metadata, err := backend.Stat(alias)
+		if err != nil {
+			return fmt.Errorf("failed to stat entity '%s' in backend '%s': %w", alias, entity.SourceBackend, err)
+		}
+		filePath, ok := metadata["path"].(string)
+		if _, isFile := metadata["rel_path"].(string); !ok || !isFile {
+			historyUnavailable(fmt.Sprintf("backend '%s' does not store entities as files", entity.SourceBackend))
+			return nil
+		}
+
+		commits, err := gitmeta.FileLog(filePath)
+		if errors.Is(err, gitmeta.ErrNotRepository) {
+			historyUnavailable("not a git repository")
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read git history for '%s': %w", alias, err)
+		}
+
+		if outputFormat == "json" {
+			if commits == nil {
+				commits = []gitmeta.Commit{}
+			}
+			jsonBytes, err := json.MarshalIndent(commits, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal history to JSON: %w", err)
+			}
+			fmt.Println(string(jsonBytes))
+			return nil
+		}
+		for _, commit := range commits {
+			fmt.Printf("%s %s\n", commit.Hash, commit.Subject)
+		}
+		return nil
+	},
+	SilenceUsage: true,
+}
+
+// historyUnavailable reports why there is no history: as a message on stdout, or with
+// --output json as an empty array on stdout and a warning on stderr.
+func historyUnavailable(reason string) {
+	if outputFormat == "json" {
+		slog.Warn("History unavailable", "reason", reason)
+		fmt.Println("[]")
+		return
+	}
+	fmt.Printf("history unavailable (%s)\n", reason)
+}
+
+func init() {
+	rootCmd.AddCommand(historyCmd)
+	historyCmd.Flags().StringVar(&historyBackend, "backend", "", "Backend to read the entity from (default: search all backends)")
+}
@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+
+	"gydnc/core/content"
+	"gydnc/model"
+	"gydnc/storage"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	importBackend    string
+	importOnConflict string
+)
+
+// importConflictModes lists the accepted --on-conflict values.
+var importConflictModes = []string{"skip", "overwrite", "fail"}
+
+var importCmd = &cobra.Command{
+	Use:   "import <archive>",
+	Short: "Load guidance entities from a bundle created by export",
+	Long: `Reads a gzipped tar archive of .g6e files, as written by 'gydnc export', and saves each
+entity into a backend (--backend, or the default backend). Member paths without the
+.g6e extension are used as aliases. Use '-' to read the archive from stdin.
+
+Each member is parsed before it is written; members that are not valid .g6e content are
+reported as failed and nothing is written for them.
+
+--on-conflict controls what happens when an alias already exists in the backend:
+  fail       report the member as failed and leave the existing entity (default)
+  skip       leave the existing entity and count the member as skipped
+  overwrite  replace the existing entity
+
+A summary of imported, skipped and failed members is printed at the end. The command
+exits with an error if any member failed.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if appContext == nil || appContext.Config == nil || appContext.EntityService == nil {
+			return fmt.Errorf("application context, configuration, or entity service not initialized")
+		}
+		validMode := false
+		for _, mode := range importConflictModes {
+			if importOnConflict == mode {
+				validMode = true
+				break
+			}
+		}
+		if !validMode {
+			return fmt.Errorf("invalid --on-conflict '%s' (valid values: %s)", importOnConflict, strings.Join(importConflictModes, ", "))
+		}
+
+		backendName := importBackend
+		if backendName == "" {
+			backendName = appContext.Config.DefaultBackend
+		}
+		if backendName == "" {
+			return fmt.Errorf("no backend to import into; specify --backend or set default_backend in config")
+		}
+
+		var in io.Reader = os.Stdin
+		if args[0] != "-" {
+			file, err := os.Open(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to open archive '%s': %w", args[0], err)
+			}
+			defer file.Close()
+			in = file
+		}
+		gzipReader, err := gzip.NewReader(in)
+		if err != nil {
+			return fmt.Errorf("failed to read archive '%s' as gzip: %w", args[0], err)
+		}
+		defer gzipReader.Close()
+		tarReader := tar.NewReader(gzipReader)
+
+		imported, skipped, failed := 0, 0, 0
+		for {
+			header, err := tarReader.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return fmt.Errorf("failed to read archive '%s': %w", args[0], err)
+			}
+			if header.Typeflag != tar.TypeReg {
+				continue
+			}
+
+			alias, err := bundleMemberAlias(header.Name)
+			if err != nil {
+				appContext.Logger.Error("Failed to import member.", "member", header.Name, "error", err)
+				failed++
+				continue
+			}
+			data, err := io.ReadAll(tarReader)
+			if err != nil {
+				return fmt.Errorf("failed to read member '%s' of archive '%s': %w", header.Name, args[0], err)
+			}
+
+			result, err := importEntity(alias, data, backendName)
+			if err != nil {
+				appContext.Logger.Error("Failed to import entity.", "alias", alias, "error", err)
+				failed++
+				continue
+			}
+			if result == "skipped" {
+				appContext.Logger.Info("Skipped existing entity.", "alias", alias, "backend", backendName)
+				skipped++
+				continue
+			}
+			appContext.Logger.Debug("Imported entity.", "alias", alias, "backend", backendName, "result", result)
+			imported++
+		}
+
+		fmt.Printf("imported: %d, skipped: %d, failed: %d\n", imported, skipped, failed)
+		if failed > 0 {
+			return fmt.Errorf("failed to import %d of the entities in '%s' into '%s'", failed, args[0], backendName)
+		}
+		return nil
+	},
+	SilenceErrors: true,
+	SilenceUsage:  true,
+}
+
+// bundleMemberAlias derives an alias from an archive member name, rejecting names that are
+// not .g6e files or that would escape the backend root.
+func bundleMemberAlias(name string) (string, error) {
+	if !strings.HasSuffix(name, bundleMemberExt) {
+		return "", fmt.Errorf("not a %s file", bundleMemberExt)
+	}
+	cleaned := path.Clean(strings.TrimSuffix(name, bundleMemberExt))
+	if cleaned == "." || path.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", fmt.Errorf("member path is not a valid alias")
+	}
+	return cleaned, nil
+}
+
+// importEntity parses data and saves it as alias in backendName, applying --on-conflict if the
+// alias exists. It returns "imported", "overwritten" or "skipped".
+func importEntity(alias string, data []byte, backendName string) (string, error) {
+	gc, err := content.ParseG6E(data)
+	if err != nil {
+		return "", fmt.Errorf("invalid .g6e content: %w", err)
+	}
+	pcid, custom := content.SplitPCID(gc.CustomMetadata)
+	entity := model.Entity{
+		Alias:          alias,
+		Title:          gc.Title,
+		Description:    gc.Description,
+		Tags:           gc.Tags,
+		Body:           gc.Body,
+		PCID:           pcid,
+		CustomMetadata: custom,
+	}
+
+	_, err = appContext.EntityService.SaveEntity(entity, backendName)
+	if err == nil {
+		return "imported", nil
+	}
+	if !errors.Is(err, storage.ErrEntityAlreadyExists) {
+		return "", err
+	}
+	switch importOnConflict {
+	case "skip":
+		return "skipped", nil
+	case "overwrite":
+		if _, err := appContext.EntityService.OverwriteEntity(entity, backendName); err != nil {
+			return "", err
+		}
+		return "overwritten", nil
+	default:
+		return "", err
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(importCmd)
+	importCmd.Flags().StringVar(&importBackend, "backend", "", "Backend to import into (default: the default backend)")
+	importCmd.Flags().StringVar(&importOnConflict, "on-conflict", "fail", "What to do when an alias already exists: skip, overwrite, or fail")
+}
@@ -3,10 +3,12 @@ package cmd
 import (
 	_ "embed"
 	"fmt"
+	"gydnc/model"
 	"gydnc/service"
 	"log/slog"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/spf13/cobra"
 )
@@ -14,21 +16,50 @@ import (
 //go:embed init_tag_ontology.md
 var tagOntologyContent []byte
 
+//go:embed init_gitignore
+var gitignoreContent []byte
+
 const (
 	defaultBackendName         = "default"
 	defaultBackendType         = "localfs"
 	defaultTagOntologyFileName = "tag_ontology.md"
+	defaultGitignoreFileName   = ".gitignore"
 )
 
 var (
-	forceInit bool
+	forceInit       bool
+	initGitignore   bool
+	initBackendType string
+	initAddBackend  string
+	initPath        string
+	initSetDefault  bool
 )
 
 var initCmd = &cobra.Command{
 	Use:   "init [path]",
 	Short: "Initialize a new gydnc repository and configuration in the specified path or current directory",
 	Long: `Creates a configuration file and tag ontology in the .gydnc directory of the target path.
-If a path is provided, initialization occurs there. Otherwise, it uses the current directory.`,
+If a path is provided, initialization occurs there. Otherwise, it uses the current directory.
+
+Like nested git repositories, a store inside another store is usually a mistake, so init
+refuses if a parent directory already contains .gydnc/config.yml and suggests using that
+store instead. Use --force to create the nested store anyway.
+
+Use --gitignore to also write a starter .gitignore in the store directory that excludes
+lock files (*.lock), temporary files (*.tmp-*) and common editor scratch files.
+
+Use --backend-type to choose the type of the default backend (localfs, the default, or
+inmem). An inmem backend is read-only and is seeded from the .g6e files placed in the
+.gydnc directory.
+
+Use --add-backend <name> to add a backend to the existing store's configuration instead
+of creating a new store, e.g. to grow a multi-backend setup one backend at a time:
+  gydnc init --add-backend staging --path ./staging
+The backend's type comes from --backend-type. --path is required for localfs and names
+the seed directory for inmem; it is resolved against the current directory. The other
+backends are left unchanged, and an existing backend with the same name is only replaced
+with --force. The default backend is kept unless --set-default is given, which makes the
+added backend the default.`,
 	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		slog.Debug("Starting 'init' command execution")
@@ -52,13 +83,27 @@ If a path is provided, initialization occurs there. Otherwise, it uses the curre
 		}
 		slog.Info("Target base path for initialization set", "path", targetBasePath)
 
+		if initAddBackend != "" {
+			return addBackendToStore(targetBasePath)
+		}
+		if cmd.Flags().Changed("path") {
+			return fmt.Errorf("--path requires --add-backend")
+		}
+		if initSetDefault {
+			return fmt.Errorf("--set-default requires --add-backend")
+		}
+
 		// Create a temporary app context for the init command
 		// This doesn't depend on any existing config
 		ctx := service.NewAppContext(nil, nil)
 		configService := service.NewConfigService(ctx)
 
+		if parentConfig, found := service.FindParentStore(targetBasePath); found && forceInit {
+			slog.Warn("Creating a guidance store nested inside an existing one", "path", targetBasePath, "parent_config", parentConfig)
+		}
+
 		// Initialize the config using our service
-		gydncDirPath, err := configService.InitConfig(targetBasePath, defaultBackendType, forceInit)
+		gydncDirPath, err := configService.InitConfig(targetBasePath, initBackendType, forceInit)
 		if err != nil {
 			return err
 		}
@@ -72,6 +117,14 @@ If a path is provided, initialization occurs there. Otherwise, it uses the curre
 		}
 		slog.Debug("Created tag_ontology.md", "path", tagOntologyPath)
 
+		if initGitignore {
+			gitignorePath := filepath.Join(gydncDirPath, defaultGitignoreFileName)
+			if err := os.WriteFile(gitignorePath, gitignoreContent, 0644); err != nil {
+				return fmt.Errorf("failed to create .gitignore at '%s': %w", gitignorePath, err)
+			}
+			slog.Debug("Created .gitignore", "path", gitignorePath)
+		}
+
 		configFilePath := filepath.Join(gydncDirPath, "config.yml")
 		slog.Debug("Created configuration file", "path", configFilePath)
 
@@ -84,7 +137,44 @@ If a path is provided, initialization occurs there. Otherwise, it uses the curre
 	},
 }
 
+// addBackendToStore adds the backend given with --add-backend to the config of the existing
+// store in targetBasePath.
+func addBackendToStore(targetBasePath string) error {
+	if initGitignore {
+		return fmt.Errorf("--gitignore cannot be combined with --add-backend")
+	}
+	configPath := filepath.Join(targetBasePath, ".gydnc", "config.yml")
+	if _, err := os.Stat(configPath); err != nil {
+		return fmt.Errorf("no guidance store found at %s; run 'gydnc init' first: %w", configPath, err)
+	}
+
+	path := initPath
+	if path != "" {
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			return fmt.Errorf("failed to get absolute path for '%s': %w", path, err)
+		}
+		path = absPath
+	}
+
+	configService := service.NewConfigService(service.NewAppContext(nil, nil))
+	if err := configService.AddBackend(configPath, initAddBackend, initBackendType, path, forceInit, initSetDefault); err != nil {
+		return err
+	}
+	slog.Info("Added backend to configuration", "backend", initAddBackend, "type", initBackendType, "path", path, "config", configPath, "set_default", initSetDefault)
+	fmt.Printf("Added %s backend '%s' to %s\n", initBackendType, initAddBackend, configPath)
+	if initSetDefault {
+		fmt.Printf("Default backend is now '%s'\n", initAddBackend)
+	}
+	return nil
+}
+
 func init() {
 	rootCmd.AddCommand(initCmd)
-	initCmd.Flags().BoolVar(&forceInit, "force", false, "Overwrite existing configuration if found")
+	initCmd.Flags().BoolVar(&forceInit, "force", false, "Overwrite existing configuration if found, or create a store nested inside another one")
+	initCmd.Flags().StringVar(&initBackendType, "backend-type", defaultBackendType, "Type of the default backend ("+strings.Join(model.SupportedBackendTypes, ", ")+")")
+	initCmd.Flags().StringVar(&initAddBackend, "add-backend", "", "Add a backend with this name to the existing store's configuration instead of creating a store")
+	initCmd.Flags().StringVar(&initPath, "path", "", "With --add-backend, the backend's directory (localfs) or seed directory (inmem)")
+	initCmd.Flags().BoolVar(&initSetDefault, "set-default", false, "With --add-backend, make the added backend the default backend")
+	initCmd.Flags().BoolVar(&initGitignore, "gitignore", false, "Also write a starter .gitignore in the store directory excluding lock, temp and editor scratch files")
 }
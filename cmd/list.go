@@ -3,26 +3,70 @@ package cmd
 import (
 	"encoding/json"
 	"fmt"
-	"log/slog" // Added for global logger in panic/early exit
 	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
 
 	// "sort" // No longer needed directly here if service sorts
 	// "path/filepath" // No longer needed directly here
 	// "gydnc/core/content" // No longer needed directly here
-	// "gydnc/filter" // No longer needed directly here
+	"gydnc/filter"
+	"gydnc/internal/utils"
 	"gydnc/model"   // Added import for model.Entity
 	"gydnc/service" // Import the service package
+	"gydnc/storage"
 
 	// "gydnc/storage/localfs" // No longer needed directly here
 
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 )
 
 var (
 	// listJSON bool // Flag becomes effectively obsolete as JSON is default
-	filterTags      string
-	extendedOutput  bool
-	listBackendName string
+	filterTags             string
+	extendedOutput         bool
+	listBackendName        string
+	listChangedVs          string
+	listExplain            bool
+	listWithPaths          bool
+	listNoHeader           bool
+	listCount              bool
+	listAliasesOnly        bool
+	listPreview            int
+	listFilterCID          string
+	listJSONL              bool
+	listSort               string
+	listReverse            bool
+	listWithID             bool
+	listBackends           string
+	listSince              time.Duration
+	listShowBackendsStatus bool
+)
+
+// listBackendStatus is one backend's entry in the list --backends-status report.
+type listBackendStatus struct {
+	Name     string `json:"name" yaml:"name"`
+	OK       bool   `json:"ok" yaml:"ok"`
+	Entities int    `json:"entities" yaml:"entities"`
+	Error    string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// listWithBackendsStatus is the JSON and YAML output of list --backends-status.
+type listWithBackendsStatus struct {
+	Entities []interface{}       `json:"entities" yaml:"entities"`
+	Backends []listBackendStatus `json:"backends" yaml:"backends"`
+}
+
+// listSortKeys lists the values accepted by --sort.
+var listSortKeys = []string{"alias", "title", "backend", "tagcount"}
+
+// Values accepted by --backends.
+const (
+	listBackendsOverride = "override"
+	listBackendsUnion    = "union"
 )
 
 // listCmd represents the list command
@@ -34,106 +78,515 @@ Supports tag filtering with the --filter-tags flag using syntax like:
 - "scope:code quality:safety" (include tags)
 - "NOT deprecated" or "-deprecated" (exclude tags)
 - "scope:* -deprecated" (wildcards and negation)
-Output is always in JSON format.`, // Updated Long description
-	Args: cobra.NoArgs,
-	Run: func(cmd *cobra.Command, args []string) {
-		defer func() {
-			if r := recover(); r != nil {
-				// Use global slog here as appContext might not be initialized or could be part of the panic
-				slog.Error("A critical error occurred in the list command", "details", fmt.Sprintf("%v", r))
-				os.Exit(1)
-			}
-		}()
+- "meta:tier=must -meta:status" (custom frontmatter field equals a value / is present)
+Filtering uses the frontmatter metadata from each backend's stat lookup, so entity
+bodies are never read to filter.
+
+For scripting, --count prints only the number of matching entities and --aliases-only
+prints one alias per line. Both respect --filter-tags; if both are given, --count wins.
+
+Use --backend <name> to list only the entities in that backend instead of merging all
+backends; --filter-tags is then applied within it. An unconfigured name is an error.
+
+Use --backends to choose how entities from several backends are combined: override
+(default, as the MCP server does) keeps one copy per alias, preferring the backends in
+backend_priority, then the default backend, then the rest by name; union lists every copy, sorted by alias and then backend, and adds
+source_backend to compact JSON output so the copies can be told apart.
+
+Use --with-paths to include the entity's file location (path, rel_path) for backends
+that store entities as files, e.g. for editor integrations.
+
+Use --preview N to add a body_preview field with the first N characters of each
+entity's body, collapsed to a single line (and a PREVIEW column in table output).
+This reads the body of every listed entity (only those that pass the filters), so it
+is slower than a plain list.
+
+Use --filter-cid <prefix> to keep only entities whose content ID (CID, the SHA-256
+of the body) starts with the given hex prefix, like an abbreviated git hash. The full
+CID is then included in the output. This reads every listed entity's body.
+
+Use --since <duration> (e.g. 24h, 90m) to keep only entities modified within that time,
+going by the file modification time in localfs backends. Entities in backends that don't
+report modification times are always included, with a warning.
 
+Use --backends-status to also report each backend's health after the listing: whether
+it could be read, how many of the listed entities came from it, and the error for
+backends that failed. With JSON or YAML output the result becomes an object with
+entities and backends keys; with --output table, --count or --aliases-only a BACKEND
+STATUS table follows the normal output. It cannot be combined with --jsonl.
+
+Use --with-id to add an id field, the SHA-256 of the backend name and alias, as a stable
+join key for external indexes. It is independent of title, tags and content, so it does
+not change when the entity is edited.
+
+Use --explain with --filter-tags to print how the filter was parsed and, for every
+entity, which tags each term matched and whether the entity matched overall.
+
+Use --changed-vs <backend> to compare a backend (--backend, or the default backend)
+against another one. The output lists aliases that were added, removed, or changed
+(by content ID) relative to the other backend.
+Output is in JSON format by default. Use --jsonl to print one compact JSON object per
+entity per line instead of a single array, so large lists can be processed as they
+stream; it combines with --extended. Use --output table for an aligned,
+human-readable table (Alias, Title, Tags, Backend); --no-header
+omits the header row. Use --output yaml for a YAML sequence with the same fields as JSON
+(compact by default, full with --extended).
+
+Use --sort to order the output by alias (default), title, backend, or tagcount (the
+number of tags), and --reverse to invert the order. Entities that tie are ordered by
+alias, so the output is deterministic.`, // Updated Long description
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
 		if appContext == nil {
-			// Use global slog here as appContext is nil
-			slog.Error("Application context not initialized.")
-			os.Exit(1)
+			return errConfigUnavailable
 		}
 
 		entityService := service.NewEntityService(appContext)
 		var allEntities []model.Entity
 		var backendErrors map[string]error // Only relevant for merged list
-		var listErr error                  // For single backend list errors
+
+		// With --explain, list everything and evaluate the filter per entity below
+		serviceFilter := filterTags
+		if listExplain {
+			serviceFilter = ""
+		}
+
+		if listChangedVs != "" {
+			sourceBackend := listBackendName
+			if sourceBackend == "" {
+				sourceBackend = appContext.Config.DefaultBackend
+			}
+			if sourceBackend == "" {
+				return fmt.Errorf("no backend to compare against '%s'; specify --backend or set default_backend in config: %w", listChangedVs, storage.ErrNoDefaultBackend)
+			}
+			diff, err := entityService.CompareBackends(sourceBackend, listChangedVs, "")
+			if err != nil {
+				return fmt.Errorf("failed to compare backend '%s' against '%s': %w", sourceBackend, listChangedVs, err)
+			}
+			jsonBytes, err := json.MarshalIndent(diff, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal backend comparison to JSON: %w", err)
+			}
+			fmt.Println(string(jsonBytes))
+			return nil
+		}
 
 		if listBackendName != "" {
-			appContext.Logger.Debug("Listing entities for specific backend", "backend", listBackendName, "filter", filterTags)
-			allEntities, listErr = entityService.ListEntitiesFromBackend(listBackendName, "", filterTags)
-			if listErr != nil {
-				// Log the error using the structured logger if available
-				if appContext.Logger != nil {
-					appContext.Logger.Error("Failed to list entities from backend", "backend", listBackendName, "error", listErr)
-				} else {
-					fmt.Fprintf(os.Stderr, "Error listing entities from backend '%s': %v\n", listBackendName, listErr)
+			if _, ok := appContext.Config.StorageBackends[listBackendName]; !ok {
+				configured := make([]string, 0, len(appContext.Config.StorageBackends))
+				for name := range appContext.Config.StorageBackends {
+					configured = append(configured, name)
 				}
-				os.Exit(1)
+				sort.Strings(configured)
+				return fmt.Errorf("backend '%s' is not configured (configured backends: %s): %w", listBackendName, strings.Join(configured, ", "), storage.ErrBackendNotFound)
+			}
+			appContext.Logger.Debug("Listing entities for specific backend", "backend", listBackendName, "filter", filterTags)
+			var err error
+			allEntities, err = entityService.ListEntitiesFromBackend(listBackendName, "", serviceFilter)
+			if err != nil {
+				return fmt.Errorf("failed to list entities from backend '%s': %w", listBackendName, err)
 			}
 			// backendErrors is not populated in this path, as we deal with a single backend.
-		} else {
+		} else if listBackends == listBackendsUnion {
+			appContext.Logger.Debug("Listing every copy of entities from all backends", "filter", filterTags)
+			allEntities, backendErrors = entityService.ListEntitiesUnion("", serviceFilter)
+		} else if listBackends == listBackendsOverride {
 			appContext.Logger.Debug("Listing merged entities from all backends", "filter", filterTags)
-			allEntities, backendErrors = entityService.ListEntitiesMerged("", filterTags)
+			allEntities, backendErrors = entityService.ListEntitiesMerged("", serviceFilter)
+		} else {
+			return fmt.Errorf("unknown --backends mode '%s' (valid modes: %s, %s)", listBackends, listBackendsOverride, listBackendsUnion)
 		}
 
 		// Log any backend errors encountered by the service (only for merged list).
 		// For single backend list, errors are fatal and handled above.
 		if listBackendName == "" && len(backendErrors) > 0 {
 			for backendName, err := range backendErrors {
-				// Prefer structured logging if available and configured in appContext.
-				if appContext.Logger != nil {
-					appContext.Logger.Warn("Error accessing backend during list operation", "backend", backendName, "error", err)
-				} else {
-					fmt.Fprintf(os.Stderr, "Warning: Error accessing backend '%s': %v\n", backendName, err)
-				}
+				appContext.Logger.Warn("Error accessing backend during list operation", "backend", backendName, "error", err)
 			}
 		}
 
-		// Output is always JSON
-		if len(allEntities) == 0 {
-			fmt.Println("[]") // Output empty JSON array
-		} else {
-			var outputEntities interface{}
-			if extendedOutput {
-				outputEntities = allEntities
-			} else {
-				type CompactEntity struct {
-					Alias string `json:"alias"`
-					// SourceBackend string `json:"source_backend"` // Removed as per user request
-					Title       string   `json:"title"`
-					Description string   `json:"description"`
-					Tags        []string `json:"tags"`
-				}
-				compactEntities := make([]CompactEntity, len(allEntities))
-				for i, entity := range allEntities {
-					compactEntities[i] = CompactEntity{
-						Alias: entity.Alias,
-						// SourceBackend: entity.SourceBackend, // Removed
-						Title:       entity.Title,
-						Description: entity.Description,
-						Tags:        entity.Tags,
-					}
+		if listSince < 0 {
+			return fmt.Errorf("--since must be a positive duration")
+		}
+		if listSince > 0 {
+			allEntities = entityService.FilterEntitiesModifiedSince(allEntities, time.Now().Add(-listSince))
+		}
+
+		if listFilterCID != "" {
+			var err error
+			allEntities, err = entityService.FilterEntitiesByCIDPrefix(allEntities, listFilterCID)
+			if err != nil {
+				return fmt.Errorf("failed to filter entities by CID prefix '%s': %w", listFilterCID, err)
+			}
+		}
+
+		if err := sortEntities(allEntities, listSort, listReverse); err != nil {
+			return err
+		}
+
+		var statuses []listBackendStatus
+		if listShowBackendsStatus {
+			if listJSONL {
+				return fmt.Errorf("--backends-status cannot be combined with --jsonl")
+			}
+			statuses = listBackendStatuses(allEntities, backendErrors)
+		}
+
+		if listExplain {
+			if err := printFilterExplanation(filterTags, allEntities); err != nil {
+				return fmt.Errorf("failed to explain filter '%s': %w", filterTags, err)
+			}
+			return nil
+		}
+
+		if listCount {
+			fmt.Println(len(allEntities))
+			printBackendStatusTable(statuses)
+			return nil
+		}
+		if listAliasesOnly {
+			for _, entity := range allEntities {
+				fmt.Println(entity.Alias)
+			}
+			printBackendStatusTable(statuses)
+			return nil
+		}
+
+		if listPreview < 0 {
+			return fmt.Errorf("--preview must be a positive number of characters")
+		}
+		var previews map[previewKey]string
+		if listPreview > 0 {
+			previews = loadBodyPreviews(entityService, allEntities, listPreview)
+		}
+
+		if (outputFormat == "table" || outputFormat == "yaml") && listJSONL {
+			return fmt.Errorf("--jsonl cannot be combined with --output %s", outputFormat)
+		}
+		if outputFormat == "table" {
+			printEntityTable(allEntities, !listNoHeader, previews)
+			printBackendStatusTable(statuses)
+			return nil
+		}
+
+		items := listOutputItems(allEntities, previews)
+		var output interface{} = items
+		if statuses != nil {
+			output = listWithBackendsStatus{Entities: items, Backends: statuses}
+		}
+		if listJSONL {
+			// One compact object per line, written as it is encoded so consumers can stream
+			encoder := json.NewEncoder(os.Stdout)
+			for _, item := range items {
+				if err := encoder.Encode(item); err != nil {
+					return fmt.Errorf("failed to write entity as JSON line: %w", err)
 				}
-				outputEntities = compactEntities
 			}
+			return nil
+		}
 
-			jsonBytes, err := json.MarshalIndent(outputEntities, "", "  ")
+		if outputFormat == "yaml" {
+			yamlBytes, err := yaml.Marshal(output)
 			if err != nil {
-				// Prefer structured logging for errors if available.
-				if appContext.Logger != nil {
-					appContext.Logger.Error("Failed to marshal entities to JSON", "error", err)
-				} else {
-					fmt.Fprintf(os.Stderr, "Error marshaling entities to JSON: %v\n", err)
-				}
-				os.Exit(1)
+				return fmt.Errorf("failed to marshal entities to YAML: %w", err)
 			}
-			fmt.Println(string(jsonBytes))
+			fmt.Print(string(yamlBytes))
+			return nil
+		}
+
+		// Default output is JSON
+		if len(items) == 0 && statuses == nil {
+			fmt.Println("[]") // Output empty JSON array
+			return nil
 		}
+		jsonBytes, err := json.MarshalIndent(output, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal entities to JSON: %w", err)
+		}
+		fmt.Println(string(jsonBytes))
+		return nil
 	},
 }
 
+// listOutputItems converts entities to the objects emitted by JSON, JSON Lines and YAML output:
+// full entities with --extended, compact ones otherwise.
+func listOutputItems(entities []model.Entity, previews map[previewKey]string) []interface{} {
+	items := make([]interface{}, 0, len(entities))
+	if extendedOutput {
+		type AnnotatedEntity struct {
+			model.Entity `yaml:",inline"`
+			ID           string  `json:"id,omitempty" yaml:"id,omitempty"`
+			Path         string  `json:"path,omitempty" yaml:"path,omitempty"`
+			RelPath      string  `json:"rel_path,omitempty" yaml:"rel_path,omitempty"`
+			BodyPreview  *string `json:"body_preview,omitempty" yaml:"body_preview,omitempty"`
+		}
+		for _, entity := range entities {
+			if previews == nil && !listWithID && !listWithPaths {
+				items = append(items, entity)
+				continue
+			}
+			annotated := AnnotatedEntity{Entity: entity}
+			if listWithID {
+				annotated.ID = entityID(entity)
+			}
+			if listWithPaths {
+				annotated.Path = entity.Path
+				annotated.RelPath = entity.RelPath
+			}
+			if previews != nil {
+				preview := previews[previewKeyOf(entity)]
+				annotated.BodyPreview = &preview
+			}
+			items = append(items, annotated)
+		}
+		return items
+	}
+
+	type CompactEntity struct {
+		ID    string `json:"id,omitempty" yaml:"id,omitempty"`
+		Alias string `json:"alias" yaml:"alias"`
+		// SourceBackend string `json:"source_backend"` // Removed as per user request
+		// SourceBackend is only set with --backends union, where an alias can appear more than once
+		SourceBackend string   `json:"source_backend,omitempty" yaml:"source_backend,omitempty"`
+		Title         string   `json:"title" yaml:"title"`
+		Description   string   `json:"description" yaml:"description"`
+		Tags          []string `json:"tags" yaml:"tags"`
+		Path          string   `json:"path,omitempty" yaml:"path,omitempty"`
+		RelPath       string   `json:"rel_path,omitempty" yaml:"rel_path,omitempty"`
+		BodyPreview   *string  `json:"body_preview,omitempty" yaml:"body_preview,omitempty"`
+		CID           string   `json:"cid,omitempty" yaml:"cid,omitempty"`
+	}
+	for _, entity := range entities {
+		compact := CompactEntity{
+			Alias: entity.Alias,
+			// SourceBackend: entity.SourceBackend, // Removed
+			Title:       entity.Title,
+			Description: entity.Description,
+			Tags:        entity.Tags,
+		}
+		if listFilterCID != "" {
+			compact.CID = entity.CID
+		}
+		if listWithID {
+			compact.ID = entityID(entity)
+		}
+		if listBackends == listBackendsUnion && listBackendName == "" {
+			compact.SourceBackend = entity.SourceBackend
+		}
+		if listWithPaths {
+			compact.Path = entity.Path
+			compact.RelPath = entity.RelPath
+		}
+		if preview, ok := previews[previewKeyOf(entity)]; ok {
+			compact.BodyPreview = &preview
+		}
+		items = append(items, compact)
+	}
+	return items
+}
+
+// entityID returns the --with-id identifier of an entity: the SHA-256 of its backend name and
+// alias. It does not change when the entity's content or metadata is edited.
+func entityID(entity model.Entity) string {
+	return utils.Sha256([]byte(entity.SourceBackend + "\x00" + entity.Alias))
+}
+
+// previewKey identifies the entity a --preview belongs to. With --backends union the same
+// alias can be listed once per backend, each copy with its own body.
+type previewKey struct {
+	backend string
+	alias   string
+}
+
+// previewKeyOf returns the previewKey of entity.
+func previewKeyOf(entity model.Entity) previewKey {
+	return previewKey{backend: entity.SourceBackend, alias: entity.Alias}
+}
+
+// loadBodyPreviews reads each entity's body from its source backend and returns a single-line
+// preview of at most n characters per entity. Entities whose body cannot be read get an empty preview.
+func loadBodyPreviews(entityService *service.EntityService, entities []model.Entity, n int) map[previewKey]string {
+	previews := make(map[previewKey]string, len(entities))
+	for _, entity := range entities {
+		full, err := entityService.GetEntity(entity.Alias, entity.SourceBackend)
+		if err != nil {
+			appContext.Logger.Warn("Failed to read entity body for preview", "alias", entity.Alias, "backend", entity.SourceBackend, "error", err)
+			previews[previewKeyOf(entity)] = ""
+			continue
+		}
+		previews[previewKeyOf(entity)] = bodyPreview(full.Body, n)
+	}
+	return previews
+}
+
+// bodyPreview collapses all whitespace in body to single spaces and keeps the first n characters.
+func bodyPreview(body string, n int) string {
+	preview := []rune(strings.Join(strings.Fields(body), " "))
+	if len(preview) > n {
+		preview = preview[:n]
+	}
+	return strings.TrimSpace(string(preview))
+}
+
+// sortEntities orders entities in place by key (one of listSortKeys), reversed if reverse is set.
+// Ties are always ordered by ascending alias.
+func sortEntities(entities []model.Entity, key string, reverse bool) error {
+	var compare func(a, b model.Entity) int
+	switch key {
+	case "alias":
+		compare = func(a, b model.Entity) int { return strings.Compare(a.Alias, b.Alias) }
+	case "title":
+		compare = func(a, b model.Entity) int { return strings.Compare(a.Title, b.Title) }
+	case "backend":
+		compare = func(a, b model.Entity) int { return strings.Compare(a.SourceBackend, b.SourceBackend) }
+	case "tagcount":
+		compare = func(a, b model.Entity) int { return len(a.Tags) - len(b.Tags) }
+	default:
+		return fmt.Errorf("unknown sort key '%s' (valid keys: %s)", key, strings.Join(listSortKeys, ", "))
+	}
+	sort.SliceStable(entities, func(i, j int) bool {
+		c := compare(entities[i], entities[j])
+		if reverse {
+			c = -c
+		}
+		if c != 0 {
+			return c < 0
+		}
+		return entities[i].Alias < entities[j].Alias
+	})
+	return nil
+}
+
+// printEntityTable prints entities as an aligned table in the given order.
+// If previews is non-nil, a PREVIEW column is added.
+func printEntityTable(entities []model.Entity, header bool, previews map[previewKey]string) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if header {
+		if previews != nil {
+			fmt.Fprintln(w, "ALIAS\tTITLE\tTAGS\tBACKEND\tPREVIEW")
+		} else {
+			fmt.Fprintln(w, "ALIAS\tTITLE\tTAGS\tBACKEND")
+		}
+	}
+	for _, entity := range entities {
+		if previews != nil {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", entity.Alias, entity.Title, strings.Join(entity.Tags, ","), entity.SourceBackend, previews[previewKeyOf(entity)])
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", entity.Alias, entity.Title, strings.Join(entity.Tags, ","), entity.SourceBackend)
+	}
+	w.Flush()
+}
+
+// listBackendStatuses reports each backend list read from (the --backend one, or all configured
+// backends) as failed if it is in backendErrors, and otherwise with its number of listed entities.
+func listBackendStatuses(entities []model.Entity, backendErrors map[string]error) []listBackendStatus {
+	names := []string{listBackendName}
+	if listBackendName == "" {
+		names = make([]string, 0, len(appContext.Config.StorageBackends))
+		for name := range appContext.Config.StorageBackends {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+
+	counts := make(map[string]int)
+	for _, entity := range entities {
+		counts[entity.SourceBackend]++
+	}
+	statuses := make([]listBackendStatus, 0, len(names))
+	for _, name := range names {
+		status := listBackendStatus{Name: name, OK: true, Entities: counts[name]}
+		if err, failed := backendErrors[name]; failed {
+			status.OK = false
+			status.Error = err.Error()
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// printBackendStatusTable prints the --backends-status report after text output; it prints
+// nothing if statuses is nil.
+func printBackendStatusTable(statuses []listBackendStatus) {
+	if statuses == nil {
+		return
+	}
+	fmt.Println()
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "BACKEND\tSTATUS\tENTITIES\tERROR")
+	for _, status := range statuses {
+		state := "ok"
+		if !status.OK {
+			state = "failed"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", status.Name, state, status.Entities, status.Error)
+	}
+	w.Flush()
+}
+
+// entityFilterExplanation is the per-entity part of the --explain output.
+type entityFilterExplanation struct {
+	Alias string `json:"alias"`
+	filter.MatchExplanation
+}
+
+// printFilterExplanation prints the parsed filter and how it evaluated against each entity as JSON.
+func printFilterExplanation(filterString string, entities []model.Entity) error {
+	f, err := filter.NewFilterFromString(filterString)
+	if err != nil {
+		return fmt.Errorf("failed to parse filter string: %w", err)
+	}
+	options := f.Options()
+
+	output := struct {
+		Filter      string                    `json:"filter"`
+		IncludeTags []string                  `json:"include_tags"`
+		ExcludeTags []string                  `json:"exclude_tags"`
+		Entities    []entityFilterExplanation `json:"entities"`
+	}{
+		Filter:      filterString,
+		IncludeTags: options.IncludeTags,
+		ExcludeTags: options.ExcludeTags,
+		Entities:    make([]entityFilterExplanation, 0, len(entities)),
+	}
+	if output.IncludeTags == nil {
+		output.IncludeTags = []string{}
+	}
+	if output.ExcludeTags == nil {
+		output.ExcludeTags = []string{}
+	}
+	for _, entity := range entities {
+		output.Entities = append(output.Entities, entityFilterExplanation{Alias: entity.Alias, MatchExplanation: f.Explain(entity)})
+	}
+
+	jsonBytes, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal filter explanation to JSON: %w", err)
+	}
+	fmt.Println(string(jsonBytes))
+	return nil
+}
+
 func init() {
 	rootCmd.AddCommand(listCmd)
 	// listCmd.Flags().BoolVar(&listJSON, "json", false, "Output in JSON format") // Flag removed, JSON is default
 	listCmd.Flags().StringVar(&filterTags, "filter-tags", "", "Filter by tags (e.g., \"scope:code -deprecated\")")
 	listCmd.Flags().BoolVar(&extendedOutput, "extended", false, "Include extended metadata in JSON output (includes source_backend)") // Clarified extended output
-	listCmd.Flags().StringVar(&listBackendName, "backend", "", "List entities only from a specific backend")
+	listCmd.Flags().StringVar(&listBackendName, "backend", "", "List entities only from the named backend (filters apply within it)")
+	listCmd.Flags().BoolVar(&listCount, "count", false, "Print only the number of matching entities (takes precedence over --aliases-only)")
+	listCmd.Flags().BoolVar(&listAliasesOnly, "aliases-only", false, "Print only matching aliases, one per line")
+	listCmd.Flags().StringVar(&listFilterCID, "filter-cid", "", "Only list entities whose content ID (CID) starts with this hex prefix")
+	listCmd.Flags().IntVar(&listPreview, "preview", 0, "Include the first N characters of each entity's body as a single-line body_preview (reads bodies)")
+	listCmd.Flags().StringVar(&listSort, "sort", "alias", "Sort by alias, title, backend, or tagcount")
+	listCmd.Flags().BoolVar(&listReverse, "reverse", false, "Reverse the sort order")
+	listCmd.Flags().BoolVar(&listJSONL, "jsonl", false, "Print one compact JSON object per entity per line (JSON Lines)")
+	listCmd.Flags().BoolVar(&listShowBackendsStatus, "backends-status", false, "Also report each backend's status and entity count")
+	listCmd.Flags().DurationVar(&listSince, "since", 0, "Only list entities modified within this duration (e.g. 24h)")
+	listCmd.Flags().StringVar(&listBackends, "backends", listBackendsOverride, "How to combine backends: override (one copy per alias, by backend_priority, then default backend, then name) or union (every copy)")
+	listCmd.Flags().BoolVar(&listWithID, "with-id", false, "Include a stable id (SHA-256 of backend and alias) for each entity")
+	listCmd.Flags().BoolVar(&listNoHeader, "no-header", false, "Omit the header row in --output table mode")
+	listCmd.Flags().BoolVar(&listWithPaths, "with-paths", false, "Include absolute (path) and backend-relative (rel_path) file paths for file-backed entities")
+	listCmd.Flags().BoolVar(&listExplain, "explain", false, "Print how --filter-tags was parsed and why each entity matched or not")
+	listCmd.Flags().StringVar(&listChangedVs, "changed-vs", "", "Compare the listed backend against another backend and report added/removed/changed aliases")
 }
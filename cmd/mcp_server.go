@@ -17,7 +17,9 @@ This allows AI agents to interact with the gydnc knowledge base through
 standardized MCP tool calls. The server exposes the following tools:
 
 - gydnc_read: Read guidance entities (operations: 'list' to discover entities, 'get' to retrieve full content)
+- gydnc_search: Search entity bodies for a substring or regex and return matching aliases with snippets
 - gydnc_write: Write guidance entities (operations: 'create' to add new entities, 'update' to modify existing ones)
+- gydnc_delete: Delete a guidance entity by alias (from the given backend, or the default backend)
 
 The server communicates via JSON-RPC over stdio.`
 
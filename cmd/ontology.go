@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"path/filepath"
+
+	"gydnc/core/ontology"
+)
+
+// checkStrictTags rejects tags not defined in the tag ontology file that lives next to the active config.
+// A missing ontology file disables the check with a warning.
+func checkStrictTags(tags []string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	ontologyPath := filepath.Join(filepath.Dir(appContext.ConfigPath), ontology.DefaultFileName)
+	o, err := ontology.Load(ontologyPath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			slog.Warn("Tag ontology file not found; skipping --strict-tags check", "path", ontologyPath)
+			return nil
+		}
+		return err
+	}
+
+	offending := o.FirstDisallowed(tags)
+	if offending == "" {
+		return nil
+	}
+	if suggestion := o.Suggest(offending); suggestion != "" {
+		return fmt.Errorf("tag '%s' is not defined in the tag ontology (%s); did you mean '%s'?", offending, ontologyPath, suggestion)
+	}
+	return fmt.Errorf("tag '%s' is not defined in the tag ontology (%s); add it there or use a defined tag", offending, ontologyPath)
+}
@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// replCommands are the commands the repl dispatches to. They only read from the store, so
+// none of them competes with the repl for standard input.
+var replCommands = []string{"list", "get", "show", "stat", "backends"}
+
+var replCmd = &cobra.Command{
+	Use:   "repl",
+	Short: "Start an interactive session for exploring guidance",
+	Long: `Starts an interactive session that reads one command per line and runs it against
+a configuration and backends loaded once at startup, avoiding the per-invocation startup
+cost when exploring a large store.
+
+Each line is a gydnc command without the leading "gydnc", e.g.:
+  list --filter-tags "scope:code"
+  get alpha --output json
+  backends
+
+Supported commands are list, get, show, stat and backends. The shorthand "filter <expr> [flags]" is
+the same as "list --filter-tags <expr> [flags]". Arguments may be quoted with single or
+double quotes. Type "help" for this summary and "exit" or "quit" (or send EOF) to leave.
+
+A failing command prints its error and the session continues.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if appContext == nil || appContext.Config == nil {
+			return fmt.Errorf("configuration not loaded; run 'gydnc init' or check config")
+		}
+
+		// Keep the loaded context for the whole session instead of re-reading config per command
+		replSession = true
+		defer func() { replSession = false }()
+
+		interactive := false
+		if stat, err := os.Stdin.Stat(); err == nil {
+			interactive = (stat.Mode() & os.ModeCharDevice) != 0
+		}
+
+		scanner := bufio.NewScanner(os.Stdin)
+		for {
+			if interactive {
+				fmt.Fprint(os.Stderr, "gydnc> ")
+			}
+			if !scanner.Scan() {
+				break
+			}
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			words, err := splitReplLine(line)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				continue
+			}
+			switch words[0] {
+			case "exit", "quit":
+				return nil
+			case "help":
+				fmt.Println("Commands: " + strings.Join(replCommands, ", ") + ", filter <expr>, help, exit")
+				continue
+			case "filter":
+				if len(words) < 2 {
+					fmt.Fprintln(os.Stderr, "Error: usage: filter <expr> [flags]")
+					continue
+				}
+				words = append([]string{"list", "--filter-tags", words[1]}, words[2:]...)
+			}
+			if !isReplCommand(words[0]) {
+				fmt.Fprintf(os.Stderr, "Error: unsupported command '%s' (supported: %s)\n", words[0], strings.Join(replCommands, ", "))
+				continue
+			}
+			if err := runReplCommand(words); err != nil {
+				fmt.Fprintf(os.Stderr, "%s\n", err)
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return fmt.Errorf("failed to read input: %w", err)
+		}
+		return nil
+	},
+}
+
+// isReplCommand reports whether name is one of replCommands.
+func isReplCommand(name string) bool {
+	for _, c := range replCommands {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+// runReplCommand executes args through the root command, then restores every flag it may
+// have set to its default.
+func runReplCommand(args []string) error {
+	defer resetFlags(rootCmd)
+
+	rootCmd.SetArgs(args)
+	return rootCmd.Execute()
+}
+
+// resetFlags returns the flags of cmd and all of its subcommands to their default values.
+func resetFlags(cmd *cobra.Command) {
+	reset := func(f *pflag.Flag) {
+		if !f.Changed {
+			return
+		}
+		if sv, ok := f.Value.(pflag.SliceValue); ok {
+			_ = sv.Replace(nil)
+		} else {
+			_ = f.Value.Set(f.DefValue)
+		}
+		f.Changed = false
+	}
+	cmd.PersistentFlags().VisitAll(reset)
+	cmd.Flags().VisitAll(reset)
+	for _, sub := range cmd.Commands() {
+		resetFlags(sub)
+	}
+}
+
+// splitReplLine splits a repl input line into words, honouring single and double quotes.
+func splitReplLine(line string) ([]string, error) {
+	var words []string
+	var current strings.Builder
+	inWord := false
+	var quote rune
+	for _, r := range line {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '"' || r == '\'':
+			quote = r
+			inWord = true
+		case r == ' ' || r == '\t':
+			if inWord {
+				words = append(words, current.String())
+				current.Reset()
+				inWord = false
+			}
+		default:
+			current.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote", quote)
+	}
+	if inWord {
+		words = append(words, current.String())
+	}
+	return words, nil
+}
+
+func init() {
+	rootCmd.AddCommand(replCmd)
+}
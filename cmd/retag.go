@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	retagFrom   string
+	retagTo     string
+	retagDryRun bool
+)
+
+var retagCmd = &cobra.Command{
+	Use:   "retag --from <old> --to <new>",
+	Short: "Rename a tag on every entity in all writable backends",
+	Long: `Renames a tag everywhere, e.g. as the tag ontology evolves:
+  gydnc retag --from quality:safe --to quality:safety
+
+Every writable backend is scanned (read-only backends are skipped with a warning), and
+each entity carrying --from has it replaced by --to in place. The order of the other tags
+is kept; if the entity already had --to, the duplicate is dropped.
+
+Use --dry-run to print what would change without writing anything. Counts are reported at
+the end; if any write fails the command exits non-zero, and entities already rewritten are
+left as they are.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if appContext == nil || appContext.Config == nil || appContext.EntityService == nil {
+			return fmt.Errorf("application context, configuration, or entity service not initialized")
+		}
+		if retagFrom == "" || retagTo == "" {
+			return fmt.Errorf("both --from and --to are required")
+		}
+		if retagFrom == retagTo {
+			return fmt.Errorf("--from and --to are the same tag")
+		}
+
+		backendEntities, backendErrors := appContext.EntityService.ListEntities("")
+		for backendName, err := range backendErrors {
+			slog.Warn("Error accessing backend during retag", "backend", backendName, "error", err)
+		}
+
+		backendNames := make([]string, 0, len(backendEntities))
+		for name := range backendEntities {
+			backendNames = append(backendNames, name)
+		}
+		sort.Strings(backendNames)
+
+		var changed, failed int
+		for _, backendName := range backendNames {
+			backend, err := appContext.GetBackend(backendName)
+			if err != nil || !backend.IsWritable() {
+				slog.Warn("Skipping read-only backend", "backend", backendName)
+				continue
+			}
+			for _, listed := range backendEntities[backendName] {
+				if !slices.Contains(listed.Tags, retagFrom) {
+					continue
+				}
+				if retagDryRun {
+					fmt.Printf("Would retag %s (backend: %s)\n", listed.Alias, backendName)
+					changed++
+					continue
+				}
+				entity, err := appContext.EntityService.GetEntity(listed.Alias, backendName)
+				if err == nil {
+					entity.Tags = replaceTag(entity.Tags, retagFrom, retagTo)
+					_, err = appContext.EntityService.OverwriteEntity(entity, backendName)
+				}
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Failed to retag %s (backend: %s): %v\n", listed.Alias, backendName, err)
+					failed++
+					continue
+				}
+				fmt.Printf("Retagged %s (backend: %s): [%s]\n", entity.Alias, backendName, strings.Join(entity.Tags, ", "))
+				changed++
+			}
+		}
+
+		if retagDryRun {
+			fmt.Printf("would retag: %d\n", changed)
+			return nil
+		}
+		fmt.Printf("retagged: %d, failed: %d\n", changed, failed)
+		if failed > 0 {
+			return fmt.Errorf("failed to retag %d entities", failed)
+		}
+		return nil
+	},
+}
+
+// replaceTag returns tags with each from replaced by to, keeping the first occurrence of any
+// tag that then appears more than once.
+func replaceTag(tags []string, from, to string) []string {
+	seen := make(map[string]bool, len(tags))
+	replaced := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if tag == from {
+			tag = to
+		}
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		replaced = append(replaced, tag)
+	}
+	return replaced
+}
+
+func init() {
+	rootCmd.AddCommand(retagCmd)
+	retagCmd.Flags().StringVar(&retagFrom, "from", "", "Tag to rename")
+	retagCmd.Flags().StringVar(&retagTo, "to", "", "New name for the tag")
+	retagCmd.Flags().BoolVar(&retagDryRun, "dry-run", false, "Print the entities that would change without writing them")
+}
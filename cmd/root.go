@@ -18,6 +18,8 @@ var (
 	showVersion  bool                // Add version flag
 	outputFormat string              // Added for --output global flag
 	appContext   *service.AppContext // Exposed to be used by other files in cmd package
+	replSession  bool                // Set while 'gydnc repl' runs; keeps appContext across commands
+	jsonErrors   bool                // Report command failures as a JSON envelope on stderr
 )
 
 var rootCmd = &cobra.Command{
@@ -57,8 +59,8 @@ func Execute() {
 	rootCmd.SilenceErrors = true
 	err := rootCmd.Execute()
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "%s\n", err)
-		os.Exit(1)
+		writeCommandError(os.Stderr, err)
+		os.Exit(exitCode(err))
 	}
 }
 
@@ -68,11 +70,12 @@ func init() {
 	// Here you will define your flags and configuration settings.
 	// Cobra supports persistent flags, which, if defined here,
 	// will be global for your application.
-	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is empty, load via GYDNC_CONFIG env var or explicit path)")
+	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default: GYDNC_CONFIG env var, else the nearest .gydnc/config.yml in the current directory or its parents)")
 	rootCmd.PersistentFlags().CountVarP(&verbosity, "verbose", "v", "Increase logging verbosity (default: WARN, -v: INFO, -vv: DEBUG)")
 	rootCmd.PersistentFlags().BoolVarP(&quiet, "quiet", "q", false, "Suppress non-error log messages (equivalent to log level ERROR)")
 	rootCmd.PersistentFlags().BoolVarP(&showVersion, "version", "V", false, "Show version and exit")
-	rootCmd.PersistentFlags().StringVar(&outputFormat, "output", "", "Output format (json, yaml)")
+	rootCmd.PersistentFlags().StringVar(&outputFormat, "output", "", "Output format (json, yaml, raw, table; supported formats vary by command)")
+	rootCmd.PersistentFlags().BoolVar(&jsonErrors, "json-errors", false, "Report command failures on stderr as a JSON object with 'error' and 'code' fields")
 
 	rootCmd.AddCommand(llmCmd) // llmCmd is defined in llm.go
 }
@@ -80,16 +83,30 @@ func init() {
 // initConfig reads in config file and ENV variables if set.
 func initConfig() {
 	// Set up logging based on verbosity/quiet flags
+	// Inside a repl session the logger and context set up at startup are reused
+	if replSession && appContext != nil {
+		return
+	}
+
 	logging.SetupLogger(verbosity, quiet)
 
 	// Determine if the current command is 'init' or 'version' (bootstrap commands)
 	requireConfig := true
+	allowConfigError := false
 	cmdName := ""
 	if len(os.Args) > 1 {
 		cmdName = os.Args[1]
 		if cmdName == "init" || cmdName == "version" {
 			requireConfig = false
 		}
+		// 'config path' reports where the config would come from, so it must run without one
+		if cmdName == "config" && len(os.Args) > 2 && os.Args[2] == "path" {
+			requireConfig = false
+		}
+		// 'config validate' reports a config that cannot be loaded as one of its problems
+		if cmdName == "config" && len(os.Args) > 2 && os.Args[2] == "validate" {
+			allowConfigError = true
+		}
 	}
 
 	// For commands that don't require config (init, version), exit early
@@ -104,19 +121,25 @@ func initConfig() {
 	// Load config using the service layer
 	configPath, err := configService.GetEffectiveConfigPath(cfgFile)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "active backend not initialized; run 'gydnc init' or check config\n")
-		os.Exit(1)
+		if allowConfigError {
+			return
+		}
+		writeCommandError(os.Stderr, fmt.Errorf("%w: %v", errConfigUnavailable, err))
+		os.Exit(exitConfig)
 	}
 
 	config, err := configService.LoadFromPath(configPath, true)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "active backend not initialized; run 'gydnc init' or check config\n")
-		os.Exit(1)
+		if allowConfigError {
+			return
+		}
+		writeCommandError(os.Stderr, fmt.Errorf("%w: %v", errConfigUnavailable, err))
+		os.Exit(exitConfig)
 	}
 
 	// Update the app context with the loaded config
 	appContext.Config = config
-	appContext.ConfigPath = configPath // Store the loaded config path in appContext
+	appContext.ConfigPath = service.PrimaryConfigPath(configPath) // Store the loaded config path in appContext
 
 	// Initialize the active backend
 	if err := InitActiveBackend(); err != nil {
@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"gydnc/model"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	showBackend string
+	showColor   string
+)
+
+// ANSI escape sequences used by show when color is enabled.
+const (
+	ansiReset     = "\x1b[0m"
+	ansiBold      = "\x1b[1m"
+	ansiDim       = "\x1b[2m"
+	ansiUnderline = "\x1b[4m"
+	ansiCyan      = "\x1b[36m"
+)
+
+var (
+	showBoldPattern = regexp.MustCompile(`\*\*([^*]+)\*\*`)
+	showCodePattern = regexp.MustCompile("`([^`]+)`")
+)
+
+var showCmd = &cobra.Command{
+	Use:   "show <alias>",
+	Short: "Show a guidance entity formatted for reading in a terminal",
+	Long: `Prints a guidance entity for a human reader: the title as a heading, the description,
+the tags as a comma-separated list, the backend it was read from, and then the body.
+
+This is a read-only view; use 'get' for machine-readable output and 'update' to change
+an entity. The copy is found as with get (the default backend first); use --backend to
+show the copy in a specific backend.
+
+With --color always (or auto, the default, when stdout is a terminal and NO_COLOR is not
+set) the heading is emphasised and the body gets a simple markdown pass: headings and
+**bold** text are shown in bold and ` + "`code`" + ` spans in cyan. With --color never the body
+is printed as stored.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if appContext == nil || appContext.Config == nil || appContext.EntityService == nil {
+			return fmt.Errorf("application context, configuration, or entity service not initialized")
+		}
+
+		var color bool
+		switch showColor {
+		case "always":
+			color = true
+		case "never":
+			color = false
+		case "auto":
+			color = stdoutIsTerminal() && os.Getenv("NO_COLOR") == ""
+		default:
+			return fmt.Errorf("invalid --color value '%s' (valid: auto, always, never)", showColor)
+		}
+
+		entity, err := appContext.EntityService.GetEntity(args[0], showBackend)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve entity '%s': %w", args[0], err)
+		}
+		fmt.Print(formatEntityForTerminal(entity, color))
+		return nil
+	},
+}
+
+// stdoutIsTerminal reports whether standard output is a character device.
+func stdoutIsTerminal() bool {
+	stat, err := os.Stdout.Stat()
+	return err == nil && (stat.Mode()&os.ModeCharDevice) != 0
+}
+
+// formatEntityForTerminal renders entity as the text printed by show.
+func formatEntityForTerminal(entity model.Entity, color bool) string {
+	var b strings.Builder
+
+	title := entity.Title
+	if title == "" {
+		title = entity.Alias
+	}
+	if color {
+		b.WriteString(ansiBold + ansiUnderline + title + ansiReset + "\n")
+	} else {
+		b.WriteString(title + "\n" + strings.Repeat("=", len([]rune(title))) + "\n")
+	}
+	if entity.Description != "" {
+		b.WriteString(entity.Description + "\n")
+	}
+	b.WriteString("\n")
+
+	tags := "(none)"
+	if len(entity.Tags) > 0 {
+		tags = strings.Join(entity.Tags, ", ")
+	}
+	meta := fmt.Sprintf("Alias: %s\nTags: %s\nBackend: %s\n", entity.Alias, tags, entity.SourceBackend)
+	if color {
+		meta = ansiDim + strings.TrimSuffix(meta, "\n") + ansiReset + "\n"
+	}
+	b.WriteString(meta)
+
+	body := strings.TrimRight(entity.Body, "\n")
+	if body != "" {
+		b.WriteString("\n")
+		if color {
+			body = markdownToANSI(body)
+		}
+		b.WriteString(body + "\n")
+	}
+	return b.String()
+}
+
+// markdownToANSI applies a minimal markdown rendering: heading lines and **bold** spans become
+// bold and `code` spans cyan. Fenced code blocks are left untouched.
+func markdownToANSI(body string) string {
+	lines := strings.Split(body, "\n")
+	inFence := false
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			lines[i] = ansiBold + strings.TrimSpace(strings.TrimLeft(line, "#")) + ansiReset
+			continue
+		}
+		line = showBoldPattern.ReplaceAllString(line, ansiBold+"$1"+ansiReset)
+		lines[i] = showCodePattern.ReplaceAllString(line, ansiCyan+"$1"+ansiReset)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func init() {
+	rootCmd.AddCommand(showCmd)
+	showCmd.Flags().StringVar(&showBackend, "backend", "", "Show the copy of the entity in this backend instead of the one found first")
+	showCmd.Flags().StringVar(&showColor, "color", "auto", "Colorize output: auto, always, or never")
+}
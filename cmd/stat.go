@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var statBackend string
+
+// StatOutput is the metadata printed by stat. Size, ModTime and Path are only set for
+// backends that store entities as files.
+type StatOutput struct {
+	Alias   string   `json:"alias"`
+	Backend string   `json:"backend"`
+	Title   string   `json:"title"`
+	Tags    []string `json:"tags"`
+	CID     string   `json:"cid,omitempty"`
+	Size    *int64   `json:"size,omitempty"`
+	ModTime string   `json:"mod_time,omitempty"`
+	Path    string   `json:"path,omitempty"`
+}
+
+var statCmd = &cobra.Command{
+	Use:   "stat <alias>",
+	Short: "Show an entity's metadata without reading its body",
+	Long: `Prints an entity's metadata using the backend's lightweight stat lookup instead of
+reading the entity: alias, backend, title, tags and content ID (CID), plus for localfs
+backends the file's size in bytes, modification time and path.
+
+The copy is found as with get; use --backend to stat the copy in a specific backend.
+Use --output json for a JSON object instead of "key: value" lines.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if appContext == nil || appContext.Config == nil || appContext.EntityService == nil {
+			return fmt.Errorf("application context, configuration, or entity service not initialized")
+		}
+		if outputFormat != "" && outputFormat != "json" {
+			return fmt.Errorf("unsupported output format '%s' for stat (supported: json)", outputFormat)
+		}
+
+		entity, metadata, err := appContext.EntityService.StatEntity(args[0], statBackend)
+		if err != nil {
+			return fmt.Errorf("failed to stat entity '%s': %w", args[0], err)
+		}
+
+		out := StatOutput{
+			Alias:   entity.Alias,
+			Backend: entity.SourceBackend,
+			Title:   entity.Title,
+			Tags:    entity.Tags,
+			CID:     entity.CID,
+		}
+		if out.Tags == nil {
+			out.Tags = []string{}
+		}
+		if size, ok := metadata["size"].(int64); ok {
+			out.Size = &size
+		}
+		if modTime, ok := metadata["mod_time"].(time.Time); ok {
+			out.ModTime = modTime.UTC().Format(time.RFC3339)
+		}
+		if _, ok := metadata["rel_path"]; ok {
+			out.Path, _ = metadata["path"].(string)
+		}
+
+		if outputFormat == "json" {
+			jsonBytes, err := json.MarshalIndent(out, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal stat output to JSON: %w", err)
+			}
+			fmt.Println(string(jsonBytes))
+			return nil
+		}
+
+		fmt.Printf("alias: %s\n", out.Alias)
+		fmt.Printf("backend: %s\n", out.Backend)
+		fmt.Printf("title: %s\n", out.Title)
+		fmt.Printf("tags: %s\n", strings.Join(out.Tags, ", "))
+		if out.CID != "" {
+			fmt.Printf("cid: %s\n", out.CID)
+		}
+		if out.Size != nil {
+			fmt.Printf("size: %d\n", *out.Size)
+		}
+		if out.ModTime != "" {
+			fmt.Printf("mod_time: %s\n", out.ModTime)
+		}
+		if out.Path != "" {
+			fmt.Printf("path: %s\n", out.Path)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(statCmd)
+	statCmd.Flags().StringVar(&statBackend, "backend", "", "Stat the copy of the entity in this backend instead of the one found first")
+}
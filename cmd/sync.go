@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	syncFrom   string
+	syncTo     string
+	syncDelete bool
+	syncDryRun bool
+	syncForce  bool
+)
+
+// syncCmd represents the sync command
+var syncCmd = &cobra.Command{
+	Use:   "sync [<from> <to>]",
+	Short: "Copy new and changed guidance entities from one backend to another",
+	Long: `Reconciles two backends by copying entities from a source backend to a destination backend.
+
+Entities that exist only in the source are created in the destination, and entities whose
+content ID differs are overwritten with the source version. With --delete, entities that
+exist only in the destination are removed as well.
+
+Backends can be given positionally (sync <from> <to>) or via --from and --to.
+Requires confirmation unless --force is specified. Use --dry-run to only show the plan.`,
+	Args: cobra.RangeArgs(0, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if appContext == nil || appContext.Config == nil || appContext.EntityService == nil {
+			return fmt.Errorf("application context, configuration, or entity service not initialized")
+		}
+
+		from, to := syncFrom, syncTo
+		switch len(args) {
+		case 0:
+		case 2:
+			if (from != "" && from != args[0]) || (to != "" && to != args[1]) {
+				return fmt.Errorf("conflicting backends given as arguments and via --from/--to")
+			}
+			from, to = args[0], args[1]
+		default:
+			return fmt.Errorf("expected both <from> and <to> backends, got %d argument(s)", len(args))
+		}
+		if from == "" || to == "" {
+			return fmt.Errorf("both a source and a destination backend are required (sync <from> <to> or --from/--to)")
+		}
+
+		diff, err := appContext.EntityService.CompareBackends(from, to, "")
+		if err != nil {
+			return fmt.Errorf("failed to compare backends '%s' and '%s': %w", from, to, err)
+		}
+
+		toRemove := 0
+		if syncDelete {
+			toRemove = len(diff.Removed)
+		}
+		if len(diff.Added)+len(diff.Changed)+toRemove == 0 {
+			appContext.Logger.Info("Backends are already in sync.", "from", from, "to", to)
+			return nil
+		}
+
+		fmt.Printf("The following changes will be applied to backend '%s':\n", to)
+		for _, alias := range diff.Added {
+			fmt.Printf("+ %s (create)\n", alias)
+		}
+		for _, alias := range diff.Changed {
+			fmt.Printf("~ %s (overwrite)\n", alias)
+		}
+		if syncDelete {
+			for _, alias := range diff.Removed {
+				fmt.Printf("- %s (delete)\n", alias)
+			}
+		}
+
+		if syncDryRun {
+			appContext.Logger.Info("Dry run; no changes were applied.", "from", from, "to", to)
+			return nil
+		}
+
+		if !syncForce {
+			fmt.Print("Proceed with sync? [y/N]: ")
+			reader := bufio.NewReader(os.Stdin)
+			resp, _ := reader.ReadString('\n')
+			resp = strings.TrimSpace(strings.ToLower(resp))
+			if resp != "y" && resp != "yes" {
+				appContext.Logger.Info("Sync aborted by user.")
+				return nil
+			}
+		}
+
+		result := appContext.EntityService.SyncBackends(diff, syncDelete)
+		appContext.Logger.Info("Sync finished.", "from", from, "to", to,
+			"copied", result.Copied, "overwritten", result.Overwritten, "deleted", result.Deleted)
+
+		if len(result.Failed) > 0 {
+			failedAliases := make([]string, 0, len(result.Failed))
+			for alias := range result.Failed {
+				failedAliases = append(failedAliases, alias)
+			}
+			sort.Strings(failedAliases)
+			for _, alias := range failedAliases {
+				appContext.Logger.Error("Failed to sync entity.", "alias", alias, "error", result.Failed[alias])
+			}
+			return fmt.Errorf("failed to sync %d of the entities from '%s' to '%s'", len(result.Failed), from, to)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(syncCmd)
+	syncCmd.Flags().StringVar(&syncFrom, "from", "", "Name of the source backend")
+	syncCmd.Flags().StringVar(&syncTo, "to", "", "Name of the destination backend")
+	syncCmd.Flags().BoolVar(&syncDelete, "delete", false, "Also delete entities that do not exist in the source backend")
+	syncCmd.Flags().BoolVar(&syncDryRun, "dry-run", false, "Show the changes that would be applied without applying them")
+	syncCmd.Flags().BoolVarP(&syncForce, "force", "f", false, "Sync without confirmation")
+}
@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+
+	"gydnc/model"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	tagFilterTags string
+	tagForce      bool
+	tagStrictTags bool
+)
+
+var tagCmd = &cobra.Command{
+	Use:   "tag",
+	Short: "Add or remove a tag on all entities matching a filter",
+	Long: `Applies a tag change to every guidance entity matching --filter-tags (same syntax as
+list), e.g. when migrating to a new tag ontology:
+  gydnc tag add quality:reviewed --filter-tags "scope:code"
+  gydnc tag remove legacy --filter-tags "legacy"
+
+Tags are deduplicated and sorted as with 'update --add-tag/--remove-tag'. The affected
+entities are listed and confirmation is requested unless --force is given. Entities in
+read-only backends are skipped with a warning, as are entities the change would not alter.`,
+}
+
+var tagAddCmd = &cobra.Command{
+	Use:   "add <tag>",
+	Short: "Add a tag to all entities matching --filter-tags",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if tagStrictTags {
+			if err := checkStrictTags(args); err != nil {
+				return err
+			}
+		}
+		return runBulkTagChange(args[0], []string{args[0]}, nil)
+	},
+}
+
+var tagRemoveCmd = &cobra.Command{
+	Use:   "remove <tag>",
+	Short: "Remove a tag from all entities matching --filter-tags",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runBulkTagChange(args[0], nil, []string{args[0]})
+	},
+}
+
+// runBulkTagChange adds and removes tags on every entity matching tagFilterTags.
+func runBulkTagChange(tag string, add, remove []string) error {
+	if appContext == nil || appContext.Config == nil || appContext.EntityService == nil {
+		return fmt.Errorf("application context, configuration, or entity service not initialized")
+	}
+	if strings.TrimSpace(tagFilterTags) == "" {
+		return fmt.Errorf("--filter-tags is required")
+	}
+
+	listed, backendErrors := appContext.EntityService.ListEntitiesMerged("", tagFilterTags)
+	for backendName, err := range backendErrors {
+		slog.Warn("Error accessing backend during tag operation", "backend", backendName, "error", err)
+	}
+
+	var toUpdate []model.Entity
+	for _, entity := range listed {
+		if slices.Equal(mergeTags(entity.Tags, add, remove), mergeTags(entity.Tags, nil, nil)) {
+			slog.Debug("Tag change does not alter entity, skipping", "alias", entity.Alias, "tag", tag)
+			continue
+		}
+		backend, err := appContext.GetBackend(entity.SourceBackend)
+		if err != nil {
+			slog.Warn("Skipping entity whose backend could not be opened", "alias", entity.Alias, "backend", entity.SourceBackend, "error", err)
+			continue
+		}
+		if !backend.IsWritable() {
+			slog.Warn("Skipping entity in read-only backend", "alias", entity.Alias, "backend", entity.SourceBackend)
+			continue
+		}
+		toUpdate = append(toUpdate, entity)
+	}
+
+	if len(toUpdate) == 0 {
+		fmt.Println("No entities to update.")
+		return nil
+	}
+
+	if !tagForce {
+		fmt.Println("The following entities will be updated:")
+		for _, e := range toUpdate {
+			fmt.Printf("- %s (backend: %s)\n", e.Alias, e.SourceBackend)
+		}
+		fmt.Print("Proceed? [y/N]: ")
+		reader := bufio.NewReader(os.Stdin)
+		resp, _ := reader.ReadString('\n')
+		resp = strings.TrimSpace(strings.ToLower(resp))
+		if resp != "y" && resp != "yes" {
+			fmt.Println("Aborted.")
+			return nil
+		}
+	}
+
+	var updated, failed int
+	for _, listedEntity := range toUpdate {
+		entity, err := appContext.EntityService.GetEntity(listedEntity.Alias, listedEntity.SourceBackend)
+		if err == nil {
+			oldTags := mergeTags(entity.Tags, nil, nil)
+			entity.Tags = mergeTags(entity.Tags, add, remove)
+			_, err = appContext.EntityService.OverwriteEntity(entity, entity.SourceBackend)
+			if err == nil {
+				fmt.Printf("Updated %s (backend: %s): [%s] -> [%s]\n", entity.Alias, entity.SourceBackend, strings.Join(oldTags, ", "), strings.Join(entity.Tags, ", "))
+				updated++
+				continue
+			}
+		}
+		fmt.Fprintf(os.Stderr, "Failed to update %s (backend: %s): %v\n", listedEntity.Alias, listedEntity.SourceBackend, err)
+		failed++
+	}
+
+	fmt.Printf("updated: %d, failed: %d\n", updated, failed)
+	if failed > 0 {
+		return fmt.Errorf("failed to update %d entities", failed)
+	}
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(tagCmd)
+	tagCmd.AddCommand(tagAddCmd)
+	tagCmd.AddCommand(tagRemoveCmd)
+	tagCmd.PersistentFlags().StringVar(&tagFilterTags, "filter-tags", "", "Tag filter selecting the entities to change (required; same syntax as list)")
+	tagCmd.PersistentFlags().BoolVarP(&tagForce, "force", "f", false, "Apply the change without confirmation")
+	tagAddCmd.Flags().BoolVar(&tagStrictTags, "strict-tags", false, "Reject the tag if it is not defined in the tag ontology file")
+}
@@ -4,11 +4,13 @@ import (
 	"bufio"
 	"fmt"
 	"log/slog"
+	"maps"
 	"os"
 	"slices" // For slices.Sort and slices.Equal
 	"strings"
+	"time"
 
-	// For AppContext
+	"gydnc/core/content"
 
 	"github.com/spf13/cobra"
 	// "gopkg.in/yaml.v3" // May not be needed directly if content package handles it
@@ -19,9 +21,20 @@ var (
 	updateDescription string
 	addTags           []string
 	removeTags        []string
-	// No explicit backend flag for update; it should operate on the entity's current backend.
+	updateStrictTags  bool
+	updateTouch       bool
+	updateBackend     string
+	updateSetMeta     []string
+	updateClearMeta   []string
+	updateFromStdin   bool
+	updateFormat      string
+	updateAppend      bool
+	updatePrepend     bool
 )
 
+// updatedAtKey is the frontmatter field refreshed by update --touch.
+const updatedAtKey = "updated_at"
+
 // updateCmd represents the update command
 var updateCmd = &cobra.Command{
 	Use:   "update <alias>",
@@ -29,10 +42,30 @@ var updateCmd = &cobra.Command{
 	Long: `Updates metadata or content of an existing guidance entity using the EntityService.
 
 The entity is identified by its alias. The update will be applied to the entity
-found in its source backend.
+found in its source backend: the default backend's copy if it has one, otherwise the
+first backend (by name) that does. When the alias exists in several backends, use
+--backend <name> to choose which copy to update.
 
 Metadata fields (title, description, tags) can be updated via flags.
-If content is piped via stdin, it will replace the existing body of the guidance.`,
+If content is piped via stdin, it will replace the existing body of the guidance.
+With --append (or --prepend), the piped content is added after (or before) the existing
+body instead, separated from it by a blank line; empty piped content changes nothing.
+With --strict-tags, tags added via --add-tag must be defined in the tag_ontology.md next to the config file.
+With --touch, the updated_at frontmatter field is set to the current UTC time and the
+entity is written even if nothing else changed, e.g. to record that it was reviewed.
+Use --set-meta key=value (repeatable) to set a custom frontmatter field, stored as a
+string, and --clear-meta key to remove one, e.g.:
+  gydnc update my-rule --set-meta status=approved --clear-meta draft_note
+The standard fields (title, description, tags) and the managed pcid cannot be set this way.
+
+With --from-stdin, stdin holds a complete entity, as JSON (the default) or, with --format
+yaml, YAML, in the form printed by 'gydnc get' or accepted by 'create --from-stdin'. Its
+title, description, tags and body replace the stored ones; fields it omits are cleared.
+custom_metadata replaces the custom frontmatter fields only if present, so the output of
+'gydnc get' can be modified and written back:
+  gydnc get my-rule | jq '.title = "Renamed"' | gydnc update my-rule --from-stdin
+Flags such as --title, --add-tag or --set-meta are applied on top of the document. As
+with other updates, nothing is written if the result is unchanged.`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		alias := args[0]
@@ -44,8 +77,34 @@ If content is piped via stdin, it will replace the existing body of the guidance
 
 		slog.Debug("Starting 'update' command with EntityService", "alias", alias)
 
-		// 1. Get the existing entity using EntityService
-		entity, err := appContext.EntityService.GetEntity(alias, "") // Search in all backends
+		if !updateFromStdin && cmd.Flags().Changed("format") {
+			return fmt.Errorf("--format requires --from-stdin")
+		}
+		if updateAppend && updatePrepend {
+			return fmt.Errorf("--append cannot be combined with --prepend")
+		}
+		if (updateAppend || updatePrepend) && updateFromStdin {
+			return fmt.Errorf("--append and --prepend cannot be combined with --from-stdin")
+		}
+
+		if updateStrictTags {
+			if err := checkStrictTags(addTags); err != nil {
+				return err
+			}
+		}
+
+		setMeta, err := parseSetMeta(updateSetMeta)
+		if err != nil {
+			return err
+		}
+		for _, key := range updateClearMeta {
+			if err := checkCustomMetaKey(key); err != nil {
+				return err
+			}
+		}
+
+		// 1. Get the existing entity using EntityService; without --backend, all backends are searched
+		entity, err := appContext.EntityService.GetEntity(alias, updateBackend)
 		if err != nil {
 			slog.Error("Failed to get entity for update", "alias", alias, "error", err)
 			return fmt.Errorf("failed to retrieve entity '%s' for update: %w", alias, err)
@@ -61,6 +120,34 @@ If content is piped via stdin, it will replace the existing body of the guidance
 		originalTags := make([]string, len(entity.Tags))
 		copy(originalTags, entity.Tags)
 		originalBody := entity.Body
+		originalCustom := entity.CustomMetadata
+
+		// With --from-stdin the document replaces the standard fields and body; flags apply on top
+		if updateFromStdin {
+			replacement, err := decodeStdinEntity(alias, updateFormat)
+			if err != nil {
+				return err
+			}
+			if updateStrictTags {
+				if err := checkStrictTags(replacement.Tags); err != nil {
+					return err
+				}
+			}
+			entity.Title = replacement.Title
+			entity.Description = replacement.Description
+			entity.Tags = slices.Sorted(slices.Values(replacement.Tags))
+			entity.Body = replacement.Body
+			if replacement.CustomMetadata != nil {
+				entity.CustomMetadata = replacement.CustomMetadata
+			}
+			if entity.Title != originalTitle || entity.Description != originalDescription || entity.Body != originalBody {
+				contentModified = true
+			}
+			// Compared by printed value, since a number decoded from JSON is a float64 but an int when read from YAML
+			if !maps.EqualFunc(entity.CustomMetadata, originalCustom, func(a, b interface{}) bool { return fmt.Sprint(a) == fmt.Sprint(b) }) {
+				contentModified = true
+			}
+		}
 
 		// 2. Apply updates to the fetched model.Entity
 		if cmd.Flags().Changed("title") {
@@ -85,28 +172,17 @@ If content is piped via stdin, it will replace the existing body of the guidance
 
 		// Handle tag modifications
 		if cmd.Flags().Changed("add-tag") || cmd.Flags().Changed("remove-tag") {
-			tagsSet := make(map[string]struct{})
-			for _, tag := range entity.Tags { // Start with current tags
-				tagsSet[tag] = struct{}{}
-			}
-			for _, tagToRemove := range removeTags {
-				delete(tagsSet, tagToRemove)
-			}
-			for _, tagToAdd := range addTags {
-				tagsSet[tagToAdd] = struct{}{}
-			}
-			updatedTags := make([]string, 0, len(tagsSet))
-			for tag := range tagsSet {
-				updatedTags = append(updatedTags, tag)
-			}
-			slices.Sort(updatedTags) // Keep tags sorted for consistency
-			entity.Tags = updatedTags
+			entity.Tags = mergeTags(entity.Tags, addTags, removeTags)
 			// contentModified will be checked later by comparing originalTags and entity.Tags
 		}
 
-		// Handle body update from stdin
+		// Handle body update from stdin, unless stdin was the whole entity
 		stat, _ := os.Stdin.Stat()
-		if (stat.Mode() & os.ModeCharDevice) == 0 { // Check if stdin is piped
+		stdinIsPiped := (stat.Mode() & os.ModeCharDevice) == 0
+		if (updateAppend || updatePrepend) && !stdinIsPiped {
+			return fmt.Errorf("--append and --prepend require content piped to stdin")
+		}
+		if stdinIsPiped && !updateFromStdin { // Check if stdin is piped
 			slog.Debug("Stdin is piped, reading new body content.")
 			scanner := bufio.NewScanner(os.Stdin)
 			var bodyBuilder strings.Builder // Use strings.Builder for efficiency
@@ -118,6 +194,11 @@ If content is piped via stdin, it will replace the existing body of the guidance
 				return fmt.Errorf("error reading new body from stdin: %w", err)
 			}
 			newBody := bodyBuilder.String()
+			if updateAppend {
+				newBody = appendBody(originalBody, newBody)
+			} else if updatePrepend {
+				newBody = prependBody(originalBody, newBody)
+			}
 			// Remove trailing newline if body is not empty, G6E anager will add one if needed.
 			// if len(newBody) > 0 && newBody[len(newBody)-1] == '\n' {
 			// 	newBody = newBody[:len(newBody)-1]
@@ -138,6 +219,38 @@ If content is piped via stdin, it will replace the existing body of the guidance
 			slog.Debug("Tags modified", "from", originalTags, "to", entity.Tags)
 		}
 
+		if len(setMeta) > 0 || len(updateClearMeta) > 0 {
+			custom := make(map[string]interface{}, len(entity.CustomMetadata)+len(setMeta))
+			for k, v := range entity.CustomMetadata {
+				custom[k] = v
+			}
+			for _, key := range updateClearMeta {
+				if _, ok := custom[key]; ok {
+					slog.Debug("Clearing custom metadata", "key", key)
+					delete(custom, key)
+					contentModified = true
+				}
+			}
+			for key, value := range setMeta {
+				if current, ok := custom[key]; !ok || current != value {
+					slog.Debug("Setting custom metadata", "key", key, "value", value)
+					custom[key] = value
+					contentModified = true
+				}
+			}
+			entity.CustomMetadata = custom
+		}
+
+		if updateTouch {
+			custom := make(map[string]interface{}, len(entity.CustomMetadata)+1)
+			for k, v := range entity.CustomMetadata {
+				custom[k] = v
+			}
+			custom[updatedAtKey] = time.Now().UTC().Format(time.RFC3339)
+			entity.CustomMetadata = custom
+			contentModified = true
+		}
+
 		// 3. If no changes, inform user and exit
 		if !contentModified {
 			// fmt.Printf("No changes detected for entity '%s'. Update not performed.\n", alias)
@@ -162,6 +275,84 @@ If content is piped via stdin, it will replace the existing body of the guidance
 	},
 }
 
+// parseSetMeta parses --set-meta key=value pairs into a map, rejecting reserved keys.
+func parseSetMeta(pairs []string) (map[string]string, error) {
+	setMeta := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid --set-meta '%s'; expected key=value", pair)
+		}
+		if err := checkCustomMetaKey(key); err != nil {
+			return nil, err
+		}
+		setMeta[key] = value
+	}
+	return setMeta, nil
+}
+
+// checkCustomMetaKey returns an error if key is a standard or managed frontmatter field.
+func checkCustomMetaKey(key string) error {
+	if content.IsStandardFrontmatterKey(key) {
+		return fmt.Errorf("metadata key '%s' is reserved; use --title, --description or --add-tag/--remove-tag instead", key)
+	}
+	if key == content.PCIDKey {
+		return fmt.Errorf("metadata key '%s' is reserved; it is managed by gydnc", key)
+	}
+	return nil
+}
+
+// appendBody returns body followed by addition, separated by a blank line. An addition that
+// is empty or only whitespace leaves body unchanged.
+func appendBody(body, addition string) string {
+	if strings.TrimSpace(addition) == "" {
+		return body
+	}
+	if body == "" {
+		return addition
+	}
+	if !strings.HasSuffix(body, "\n") {
+		body += "\n"
+	}
+	return body + "\n" + addition
+}
+
+// prependBody returns addition followed by body, separated by a blank line. An addition that
+// is empty or only whitespace leaves body unchanged.
+func prependBody(body, addition string) string {
+	if strings.TrimSpace(addition) == "" {
+		return body
+	}
+	if body == "" {
+		return addition
+	}
+	if !strings.HasSuffix(addition, "\n") {
+		addition += "\n"
+	}
+	return addition + "\n" + body
+}
+
+// mergeTags returns current with remove taken out and add put in, deduplicated and sorted.
+func mergeTags(current, add, remove []string) []string {
+	tagsSet := make(map[string]struct{})
+	for _, tag := range current {
+		tagsSet[tag] = struct{}{}
+	}
+	for _, tagToRemove := range remove {
+		delete(tagsSet, tagToRemove)
+	}
+	for _, tagToAdd := range add {
+		tagsSet[tagToAdd] = struct{}{}
+	}
+	updatedTags := make([]string, 0, len(tagsSet))
+	for tag := range tagsSet {
+		updatedTags = append(updatedTags, tag)
+	}
+	slices.Sort(updatedTags) // Keep tags sorted for consistency
+	return updatedTags
+}
+
 func init() {
 	rootCmd.AddCommand(updateCmd)
 	// "update" command takes flags to modify metadata elements
@@ -169,4 +360,13 @@ func init() {
 	updateCmd.Flags().StringVar(&updateDescription, "description", "", "New description for the guidance file")
 	updateCmd.Flags().StringSliceVar(&addTags, "add-tag", nil, "Tags to add to the guidance file (comma-separated)")
 	updateCmd.Flags().StringSliceVar(&removeTags, "remove-tag", nil, "Tags to remove from the guidance file (comma-separated)")
+	updateCmd.Flags().BoolVar(&updateTouch, "touch", false, "Set updated_at to the current time and write the entity even if nothing else changed")
+	updateCmd.Flags().StringArrayVar(&updateSetMeta, "set-meta", nil, "Set a custom frontmatter field (key=value; repeatable)")
+	updateCmd.Flags().StringSliceVar(&updateClearMeta, "clear-meta", nil, "Remove custom frontmatter fields (comma-separated keys)")
+	updateCmd.Flags().BoolVar(&updateAppend, "append", false, "Append the piped content to the existing body instead of replacing it")
+	updateCmd.Flags().BoolVar(&updatePrepend, "prepend", false, "Prepend the piped content to the existing body instead of replacing it")
+	updateCmd.Flags().BoolVar(&updateFromStdin, "from-stdin", false, "Replace title, description, tags and body with a whole entity read from stdin")
+	updateCmd.Flags().StringVar(&updateFormat, "format", "json", "Format of the entity read with --from-stdin (json, yaml)")
+	updateCmd.Flags().StringVar(&updateBackend, "backend", "", "Update the copy of the entity in this backend instead of the one found first")
+	updateCmd.Flags().BoolVar(&updateStrictTags, "strict-tags", false, "Reject added tags not defined in the tag ontology file")
 }
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+)
+
+var validateJSON bool
+
+// validateCmd represents the validate command
+var validateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Lint guidance entities in all configured backends",
+	Long: `Reads every guidance entity from every configured backend and reports problems:
+- files that fail to parse (same strict frontmatter rules as the reader)
+- entities with an empty title
+- tags that are neither a bareword (e.g. "recipe") nor namespace:value (e.g. "lang:go")
+
+Exits with a non-zero status if any problems are found, which makes it suitable for CI.
+Use --json to print the problems as a JSON array of {alias, backend, problems[]}.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if appContext == nil || appContext.Config == nil || appContext.EntityService == nil {
+			return fmt.Errorf("application context, configuration, or entity service not initialized")
+		}
+
+		reports, checked, backendErrors := appContext.EntityService.ValidateEntities()
+
+		backendNames := make([]string, 0, len(backendErrors))
+		for name := range backendErrors {
+			backendNames = append(backendNames, name)
+		}
+		sort.Strings(backendNames)
+		for _, name := range backendNames {
+			appContext.Logger.Error("Error accessing backend during validation", "backend", name, "error", backendErrors[name])
+		}
+
+		if validateJSON {
+			jsonBytes, err := json.MarshalIndent(reports, "", "  ")
+			if err != nil {
+				return fmt.Errorf("marshalling validation results to JSON: %w", err)
+			}
+			fmt.Println(string(jsonBytes))
+		} else {
+			for _, report := range reports {
+				for _, problem := range report.Problems {
+					fmt.Printf("%s (backend: %s): %s\n", report.Alias, report.Backend, problem)
+				}
+			}
+		}
+
+		if len(reports) > 0 {
+			return fmt.Errorf("validation failed: %d of %d entities have problems", len(reports), checked)
+		}
+		if len(backendErrors) > 0 {
+			return fmt.Errorf("validation failed: %d backend(s) could not be accessed", len(backendErrors))
+		}
+		appContext.Logger.Info("All entities are valid.", "checked", checked)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(validateCmd)
+	validateCmd.Flags().BoolVar(&validateJSON, "json", false, "Output validation problems as JSON")
+}
@@ -35,10 +35,62 @@ type GuidanceContent struct {
 	Title       string   `yaml:"title"`
 	Description string   `yaml:"description,omitempty"`
 	Tags        []string `yaml:"tags,omitempty"`
+	// CustomMetadata holds any non-standard frontmatter keys (e.g. author, severity)
+	// so they survive a parse/serialize round trip. Emitted after the standard fields.
+	CustomMetadata map[string]interface{} `yaml:"-"`
 	// Body is not part of YAML, it's the content after the second '---'
 	Body string `yaml:"-"` // Ignored by YAML marshaller/unmarshaller
 }
 
+// standardFrontmatterKeys are the frontmatter keys modelled directly by GuidanceContent.
+var standardFrontmatterKeys = map[string]bool{
+	"title":       true,
+	"description": true,
+	"tags":        true,
+}
+
+// IsStandardFrontmatterKey reports whether key is modelled directly by GuidanceContent
+// (title, description, tags) rather than kept in CustomMetadata.
+func IsStandardFrontmatterKey(key string) bool {
+	return standardFrontmatterKeys[key]
+}
+
+// PCIDKey is the frontmatter key holding the parent content ID (PCID): the CID of the version
+// an entity replaced. It is kept in CustomMetadata when parsing; use SplitPCID to extract it.
+const PCIDKey = "pcid"
+
+// SplitPCID separates the parent CID from the other custom frontmatter fields.
+// custom itself is never modified; if it holds a pcid, the other fields are returned in a new map.
+func SplitPCID(custom map[string]interface{}) (string, map[string]interface{}) {
+	pcid, ok := custom[PCIDKey].(string)
+	if !ok {
+		return "", custom
+	}
+	rest := make(map[string]interface{}, len(custom)-1)
+	for k, v := range custom {
+		if k != PCIDKey {
+			rest[k] = v
+		}
+	}
+	if len(rest) == 0 {
+		rest = nil
+	}
+	return pcid, rest
+}
+
+// JoinPCID returns the custom frontmatter fields with pcid added, or custom unchanged if pcid is empty.
+func JoinPCID(custom map[string]interface{}, pcid string) map[string]interface{} {
+	if pcid == "" {
+		return custom
+	}
+	joined := make(map[string]interface{}, len(custom)+1)
+	for k, v := range custom {
+		joined[k] = v
+	}
+	joined[PCIDKey] = pcid
+	return joined
+}
+
 // frontmatterYAML is a temporary struct used for marshalling only the YAML frontmatter fields.
 // This prevents the Body field of GuidanceContent from being included in the YAML output.
 type frontmatterYAML struct {
@@ -90,6 +142,20 @@ func ParseG6E(fileContent []byte) (*GuidanceContent, error) {
 		return nil, fmt.Errorf("failed to parse YAML frontmatter: %w", err)
 	}
 
+	var rawFrontmatter map[string]interface{}
+	if err := yaml.Unmarshal(yamlData, &rawFrontmatter); err != nil {
+		return nil, fmt.Errorf("failed to parse YAML frontmatter: %w", err)
+	}
+	for k, v := range rawFrontmatter {
+		if standardFrontmatterKeys[k] {
+			continue
+		}
+		if gc.CustomMetadata == nil {
+			gc.CustomMetadata = make(map[string]interface{})
+		}
+		gc.CustomMetadata[k] = v
+	}
+
 	gc.Body = string(bodyContent)
 
 	return &gc, nil
@@ -98,14 +164,9 @@ func ParseG6E(fileContent []byte) (*GuidanceContent, error) {
 // ToFileContent serializes a GuidanceContent struct back into a byte slice
 // formatted as a .g6e file (YAML frontmatter + Markdown body).
 func (gc *GuidanceContent) ToFileContent() ([]byte, error) {
-	fm := frontmatterYAML{
-		Title:       gc.Title,
-		Description: gc.Description,
-		Tags:        gc.Tags,
-	}
-	yamlData, err := yaml.Marshal(&fm)
+	yamlData, err := gc.MarshalFrontmatter()
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal YAML frontmatter: %w", err)
+		return nil, err
 	}
 
 	var buffer bytes.Buffer
@@ -127,15 +188,33 @@ func (gc *GuidanceContent) ToFileContent() ([]byte, error) {
 	return buffer.Bytes(), nil
 }
 
-// MarshalFrontmatter serializes only the frontmatter-related fields (Title, Description, Tags)
-// of the GuidanceContent to a YAML byte slice.
+// MarshalFrontmatter serializes only the frontmatter-related fields (Title, Description, Tags,
+// then any CustomMetadata keys in sorted order) of the GuidanceContent to a YAML byte slice.
 func (gc *GuidanceContent) MarshalFrontmatter() ([]byte, error) {
 	fm := frontmatterYAML{ // Uses the internal, unexported struct
 		Title:       gc.Title,
 		Description: gc.Description,
 		Tags:        gc.Tags,
 	}
-	return yaml.Marshal(&fm)
+	yamlData, err := yaml.Marshal(&fm)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal YAML frontmatter: %w", err)
+	}
+
+	custom := make(map[string]interface{}, len(gc.CustomMetadata))
+	for k, v := range gc.CustomMetadata {
+		if !standardFrontmatterKeys[k] {
+			custom[k] = v
+		}
+	}
+	if len(custom) == 0 {
+		return yamlData, nil
+	}
+	customData, err := yaml.Marshal(custom) // Map keys are emitted in sorted order
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal custom YAML frontmatter: %w", err)
+	}
+	return append(yamlData, customData...), nil
 }
 
 // GetContentID computes and returns the SHA256 hash of the Body content.
@@ -0,0 +1,80 @@
+package content
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGuidanceContent_CustomMetadataRoundTrip(t *testing.T) {
+	input := []byte("---\ntitle: Original\nauthor: jane\nseverity: 3\ntags:\n  - go\nreviewers:\n  - a\n  - b\n---\nBody\n")
+
+	gc, err := ParseG6E(input)
+	if err != nil {
+		t.Fatalf("ParseG6E() error = %v", err)
+	}
+	wantCustom := map[string]interface{}{
+		"author":    "jane",
+		"severity":  3,
+		"reviewers": []interface{}{"a", "b"},
+	}
+	if !reflect.DeepEqual(gc.CustomMetadata, wantCustom) {
+		t.Errorf("CustomMetadata = %#v, want %#v", gc.CustomMetadata, wantCustom)
+	}
+
+	gc.Title = "Changed"
+	out, err := gc.ToFileContent()
+	if err != nil {
+		t.Fatalf("ToFileContent() error = %v", err)
+	}
+	want := "---\ntitle: Changed\ntags:\n    - go\nauthor: jane\nreviewers:\n    - a\n    - b\nseverity: 3\n---\nBody\n"
+	if string(out) != want {
+		t.Errorf("ToFileContent() =\n%s\nwant\n%s", out, want)
+	}
+
+	reparsed, err := ParseG6E(out)
+	if err != nil {
+		t.Fatalf("ParseG6E(round trip) error = %v", err)
+	}
+	if !reflect.DeepEqual(reparsed.CustomMetadata, wantCustom) {
+		t.Errorf("round trip CustomMetadata = %#v, want %#v", reparsed.CustomMetadata, wantCustom)
+	}
+}
+
+func TestGuidanceContent_NoCustomMetadata(t *testing.T) {
+	gc, err := ParseG6E([]byte("---\ntitle: Plain\n---\nBody\n"))
+	if err != nil {
+		t.Fatalf("ParseG6E() error = %v", err)
+	}
+	if gc.CustomMetadata != nil {
+		t.Errorf("CustomMetadata = %#v, want nil", gc.CustomMetadata)
+	}
+	out, err := gc.ToFileContent()
+	if err != nil {
+		t.Fatalf("ToFileContent() error = %v", err)
+	}
+	if string(out) != "---\ntitle: Plain\n---\nBody\n" {
+		t.Errorf("ToFileContent() = %q", out)
+	}
+}
+
+func TestSplitJoinPCID(t *testing.T) {
+	custom := map[string]interface{}{"owner": "team-a", PCIDKey: "abc123"}
+
+	pcid, rest := SplitPCID(custom)
+	if pcid != "abc123" || !reflect.DeepEqual(rest, map[string]interface{}{"owner": "team-a"}) {
+		t.Errorf("SplitPCID() = %q, %v", pcid, rest)
+	}
+	if _, ok := custom[PCIDKey]; !ok {
+		t.Errorf("SplitPCID() modified its input")
+	}
+
+	if joined := JoinPCID(rest, pcid); !reflect.DeepEqual(joined, custom) {
+		t.Errorf("JoinPCID() = %v, want %v", joined, custom)
+	}
+	if joined := JoinPCID(rest, ""); !reflect.DeepEqual(joined, rest) {
+		t.Errorf("JoinPCID() with empty pcid = %v, want %v", joined, rest)
+	}
+	if pcid, rest := SplitPCID(map[string]interface{}{PCIDKey: "abc123"}); pcid != "abc123" || rest != nil {
+		t.Errorf("SplitPCID() with only pcid = %q, %v; want nil rest", pcid, rest)
+	}
+}
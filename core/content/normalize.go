@@ -0,0 +1,18 @@
+package content
+
+import "strings"
+
+// NormalizeBody returns body in canonical form: line endings converted to "\n", trailing
+// whitespace stripped from every line, and trailing blank lines collapsed into a single
+// final newline. An all-whitespace body normalizes to "".
+func NormalizeBody(body string) string {
+	lines := strings.Split(strings.ReplaceAll(body, "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t\r")
+	}
+	normalized := strings.TrimRight(strings.Join(lines, "\n"), "\n")
+	if normalized == "" {
+		return ""
+	}
+	return normalized + "\n"
+}
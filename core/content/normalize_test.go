@@ -0,0 +1,27 @@
+package content
+
+import "testing"
+
+func TestNormalizeBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{name: "empty", body: "", want: ""},
+		{name: "whitespace only", body: " \n\t\n", want: ""},
+		{name: "adds final newline", body: "rule", want: "rule\n"},
+		{name: "strips trailing whitespace", body: "first  \nsecond\t\n", want: "first\nsecond\n"},
+		{name: "collapses trailing blank lines", body: "rule\n\n\n", want: "rule\n"},
+		{name: "keeps inner blank lines and indentation", body: "a\n\n    code\n", want: "a\n\n    code\n"},
+		{name: "converts CRLF", body: "a\r\nb\r\n", want: "a\nb\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeBody(tt.body); got != tt.want {
+				t.Errorf("NormalizeBody(%q) = %q, want %q", tt.body, got, tt.want)
+			}
+		})
+	}
+}
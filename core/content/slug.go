@@ -0,0 +1,27 @@
+package content
+
+import (
+	"strings"
+	"unicode"
+)
+
+// SlugifyTitle derives an alias from a title: lowercase, runs of whitespace, '-' and '_' become
+// a single hyphen, and all other punctuation is dropped. The result has no leading or trailing
+// hyphens and is empty if the title has no letters or digits.
+func SlugifyTitle(title string) string {
+	var slug strings.Builder
+	pendingHyphen := false
+	for _, r := range strings.ToLower(title) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if pendingHyphen && slug.Len() > 0 {
+				slug.WriteByte('-')
+			}
+			pendingHyphen = false
+			slug.WriteRune(r)
+		case unicode.IsSpace(r) || r == '-' || r == '_':
+			pendingHyphen = true
+		}
+	}
+	return slug.String()
+}
@@ -0,0 +1,23 @@
+package content
+
+import "testing"
+
+func TestSlugifyTitle(t *testing.T) {
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{title: "Error Handling", want: "error-handling"},
+		{title: "  Don't panic!  ", want: "dont-panic"},
+		{title: "Go: Use errors.Is / errors.As", want: "go-use-errorsis-errorsas"},
+		{title: "snake_case -- and  spaces", want: "snake-case-and-spaces"},
+		{title: "Café au lait", want: "café-au-lait"},
+		{title: "!!!", want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := SlugifyTitle(tt.title); got != tt.want {
+			t.Errorf("SlugifyTitle(%q) = %q, want %q", tt.title, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package content
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// EstimateTokens returns a rough estimate of the number of LLM tokens in text.
+// It is a heuristic, not a real tokenizer: it takes the larger of the word count
+// and one token per four characters, which tracks common BPE tokenizers for English
+// prose and markdown reasonably well. Use it for budgeting, not exact accounting.
+func EstimateTokens(text string) int {
+	words := len(strings.Fields(text))
+	byChars := (utf8.RuneCountInString(text) + 3) / 4
+	return max(words, byChars)
+}
@@ -0,0 +1,24 @@
+package content
+
+import "testing"
+
+func TestEstimateTokens(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want int
+	}{
+		{name: "empty", text: "", want: 0},
+		{name: "prose uses character estimate", text: "Keep functions small and focused.", want: 9},
+		{name: "short words use word count", text: "a b c d e f g h", want: 8},
+		{name: "partial chunk rounds up", text: "hello", want: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EstimateTokens(tt.text); got != tt.want {
+				t.Errorf("EstimateTokens(%q) = %d, want %d", tt.text, got, tt.want)
+			}
+		})
+	}
+}
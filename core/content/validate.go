@@ -0,0 +1,37 @@
+package content
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// tagPattern accepts bareword tags ("recipe") and namespaced tags ("lang:go", "category:sub:value").
+// Segments may contain letters, digits, '-', '_' and '.', and must not be empty.
+var tagPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*(:[A-Za-z0-9][A-Za-z0-9_.-]*)*$`)
+
+// IsValidTag reports whether tag is a bareword or a namespace:value tag.
+func IsValidTag(tag string) bool {
+	return tagPattern.MatchString(tag)
+}
+
+// ValidateG6E lints the raw content of a .g6e file and returns a list of human-readable problems.
+// It uses ParseG6E, so the validator applies exactly the same delimiter rules as the reader.
+// An empty result means the content is valid.
+func ValidateG6E(fileContent []byte) []string {
+	parsed, err := ParseG6E(fileContent)
+	if err != nil {
+		return []string{err.Error()}
+	}
+
+	var problems []string
+	if strings.TrimSpace(parsed.Title) == "" {
+		problems = append(problems, "title is empty")
+	}
+	for _, tag := range parsed.Tags {
+		if !IsValidTag(tag) {
+			problems = append(problems, fmt.Sprintf("tag '%s' is not a bareword or namespace:value tag", tag))
+		}
+	}
+	return problems
+}
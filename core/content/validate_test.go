@@ -0,0 +1,79 @@
+package content
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIsValidTag(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want bool
+	}{
+		{tag: "recipe", want: true},
+		{tag: "best-practice", want: true},
+		{tag: "lang:go", want: true},
+		{tag: "category:sub:value", want: true},
+		{tag: "v1.2_beta", want: true},
+		{tag: "", want: false},
+		{tag: "has space", want: false},
+		{tag: "lang:", want: false},
+		{tag: ":go", want: false},
+		{tag: "lang::go", want: false},
+		{tag: "scope:*", want: false},
+		{tag: "-deprecated", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := IsValidTag(tt.tag); got != tt.want {
+			t.Errorf("IsValidTag(%q) = %v, want %v", tt.tag, got, tt.want)
+		}
+	}
+}
+
+func TestValidateG6E(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected []string
+	}{
+		{
+			name:     "valid guidance",
+			content:  "---\ntitle: Valid\ntags:\n  - lang:go\n  - recipe\n---\nBody\n",
+			expected: nil,
+		},
+		{
+			name:     "missing opening delimiter",
+			content:  "title: Broken\n---\nBody\n",
+			expected: []string{"malformed guidance: must start with '---\n' delimiter"},
+		},
+		{
+			name:     "missing closing delimiter",
+			content:  "---\ntitle: Broken\nBody\n",
+			expected: []string{"malformed guidance: missing closing '\n---\n' delimiter for frontmatter"},
+		},
+		{
+			name:     "empty title",
+			content:  "---\ndescription: No title\n---\nBody\n",
+			expected: []string{"title is empty"},
+		},
+		{
+			name:    "empty title and malformed tags",
+			content: "---\ntitle: \"  \"\ntags:\n  - ok\n  - not ok\n  - \"bad:\"\n---\n",
+			expected: []string{
+				"title is empty",
+				"tag 'not ok' is not a bareword or namespace:value tag",
+				"tag 'bad:' is not a bareword or namespace:value tag",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidateG6E([]byte(tt.content))
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("ValidateG6E() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
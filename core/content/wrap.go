@@ -0,0 +1,61 @@
+package content
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// WrapBody hard-wraps each line of a markdown body to width columns for display.
+// Lines are broken only at spaces, so words longer than width are kept intact, and
+// continuation lines keep the original line's leading indentation. Lines inside
+// ``` or ~~~ code fences are left untouched. A width of zero or less returns body unchanged.
+func WrapBody(body string, width int) string {
+	if width <= 0 {
+		return body
+	}
+
+	lines := strings.Split(body, "\n")
+	wrapped := make([]string, 0, len(lines))
+	fence := ""
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if fence != "" {
+			if strings.HasPrefix(trimmed, fence) {
+				fence = ""
+			}
+			wrapped = append(wrapped, line)
+			continue
+		}
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			fence = trimmed[:3]
+			wrapped = append(wrapped, line)
+			continue
+		}
+		wrapped = append(wrapped, wrapLine(line, width)...)
+	}
+	return strings.Join(wrapped, "\n")
+}
+
+// wrapLine breaks a single line at spaces so that no part exceeds width, where possible.
+func wrapLine(line string, width int) []string {
+	if utf8.RuneCountInString(line) <= width {
+		return []string{line}
+	}
+	indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+	words := strings.Fields(line)
+	if len(words) == 0 {
+		return []string{line}
+	}
+
+	var result []string
+	current := indent + words[0]
+	for _, word := range words[1:] {
+		if utf8.RuneCountInString(current)+1+utf8.RuneCountInString(word) > width {
+			result = append(result, current)
+			current = indent + word
+			continue
+		}
+		current += " " + word
+	}
+	return append(result, current)
+}
@@ -0,0 +1,57 @@
+package content
+
+import "testing"
+
+func TestWrapBody(t *testing.T) {
+	tests := []struct {
+		name  string
+		body  string
+		width int
+		want  string
+	}{
+		{
+			name:  "short lines unchanged",
+			body:  "one two\nthree",
+			width: 20,
+			want:  "one two\nthree",
+		},
+		{
+			name:  "breaks at spaces",
+			body:  "the quick brown fox jumps over the lazy dog",
+			width: 15,
+			want:  "the quick brown\nfox jumps over\nthe lazy dog",
+		},
+		{
+			name:  "long word is not split",
+			body:  "see https://example.com/a/very/long/url now",
+			width: 10,
+			want:  "see\nhttps://example.com/a/very/long/url\nnow",
+		},
+		{
+			name:  "indentation kept on continuation lines",
+			body:  "  indented text that wraps",
+			width: 15,
+			want:  "  indented text\n  that wraps",
+		},
+		{
+			name:  "code fences untouched",
+			body:  "```go\nfunc veryLongFunctionName(argumentOne, argumentTwo int) {}\n```\nwrap this line please",
+			width: 12,
+			want:  "```go\nfunc veryLongFunctionName(argumentOne, argumentTwo int) {}\n```\nwrap this\nline please",
+		},
+		{
+			name:  "zero width disables wrapping",
+			body:  "the quick brown fox",
+			width: 0,
+			want:  "the quick brown fox",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WrapBody(tt.body, tt.width); got != tt.want {
+				t.Errorf("WrapBody() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
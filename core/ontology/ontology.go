@@ -0,0 +1,137 @@
+package ontology
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// DefaultFileName is the name of the tag ontology file written by `gydnc init`
+// next to the configuration file.
+const DefaultFileName = "tag_ontology.md"
+
+// termPattern matches a single tag term such as "recipe", "lang:go" or "repo:*".
+var termPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_.-]*(:([a-z0-9][a-z0-9_.-]*|\*))*$`)
+
+// Ontology is the set of tags allowed by a tag ontology file.
+type Ontology struct {
+	tags       map[string]bool
+	namespaces map[string]bool // namespaces declared as "ns:*", allowing any value
+}
+
+// Load reads and parses the ontology file at path.
+func Load(path string) (*Ontology, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tag ontology '%s': %w", path, err)
+	}
+	return Parse(data), nil
+}
+
+// Parse extracts allowed tags from markdown list items in the ontology format written by `gydnc init`:
+//
+//   - recipe: Step-by-step instructions   (bareword tag with a description)
+//   - lang:go                             (namespace:value tag)
+//   - repo:*                              (any value in the repo namespace)
+//
+// List items that are not a single tag term (e.g. prose guidelines) are ignored.
+func Parse(data []byte) *Ontology {
+	o := &Ontology{tags: make(map[string]bool), namespaces: make(map[string]bool)}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		item, ok := strings.CutPrefix(line, "- ")
+		if !ok {
+			continue
+		}
+		item = strings.TrimSpace(item)
+		if term, _, found := strings.Cut(item, ": "); found {
+			item = term
+		}
+		item = strings.ToLower(strings.Trim(item, "`"))
+		if !termPattern.MatchString(item) {
+			continue
+		}
+		if ns, ok := strings.CutSuffix(item, ":*"); ok {
+			o.namespaces[ns] = true
+			continue
+		}
+		o.tags[item] = true
+	}
+	return o
+}
+
+// Allows reports whether tag is defined by the ontology. Matching is case-insensitive.
+func (o *Ontology) Allows(tag string) bool {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if o.tags[tag] {
+		return true
+	}
+	for ns := range o.namespaces {
+		if strings.HasPrefix(tag, ns+":") {
+			return true
+		}
+	}
+	return false
+}
+
+// FirstDisallowed returns the first tag not allowed by the ontology, or "" if all are allowed.
+func (o *Ontology) FirstDisallowed(tags []string) string {
+	for _, tag := range tags {
+		if !o.Allows(tag) {
+			return tag
+		}
+	}
+	return ""
+}
+
+// Suggest returns the defined tag closest to tag, or "" if nothing is close enough.
+func (o *Ontology) Suggest(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	known := make([]string, 0, len(o.tags))
+	for t := range o.tags {
+		known = append(known, t)
+	}
+	sort.Strings(known)
+
+	best, bestDist := "", -1
+	for _, candidate := range known {
+		d := levenshtein(tag, candidate)
+		if bestDist < 0 || d < bestDist {
+			best, bestDist = candidate, d
+		}
+	}
+	maxDist := len(tag) / 2
+	if maxDist < 2 {
+		maxDist = 2
+	}
+	if bestDist < 0 || bestDist > maxDist {
+		return ""
+	}
+	return best
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,87 @@
+package ontology
+
+import (
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"testing"
+)
+
+const sampleOntology = `# Tag Ontology
+
+## Usage Guidelines
+
+- Use lowercase for all tags
+- Prefer singular forms (e.g., "recipe" not "recipes")
+
+## Standard Tags
+
+- recipe: Step-by-step instructions for accomplishing tasks
+- best-practice: Recommended approaches based on experience
+
+## Core Tags
+- lang:go
+- lang:python
+- repo:*
+`
+
+func TestOntology_Allows(t *testing.T) {
+	o := Parse([]byte(sampleOntology))
+
+	tests := []struct {
+		tag  string
+		want bool
+	}{
+		{"recipe", true},
+		{"Recipe", true},
+		{"best-practice", true},
+		{"lang:go", true},
+		{"LANG:GO", true},
+		{"repo:anything", true},
+		{"repo", false},
+		{"lang:rust", false},
+		{"recipes", false},
+		{"use", false},
+		{"lowercase", false},
+	}
+	for _, tt := range tests {
+		if got := o.Allows(tt.tag); got != tt.want {
+			t.Errorf("Allows(%q) = %v, want %v", tt.tag, got, tt.want)
+		}
+	}
+}
+
+func TestOntology_FirstDisallowed(t *testing.T) {
+	o := Parse([]byte(sampleOntology))
+	if got := o.FirstDisallowed([]string{"recipe", "lang:golang", "nope"}); got != "lang:golang" {
+		t.Errorf("FirstDisallowed() = %q, want %q", got, "lang:golang")
+	}
+	if got := o.FirstDisallowed([]string{"recipe", "lang:go"}); got != "" {
+		t.Errorf("FirstDisallowed() = %q, want empty", got)
+	}
+}
+
+func TestOntology_Suggest(t *testing.T) {
+	o := Parse([]byte(sampleOntology))
+	tests := []struct {
+		tag  string
+		want string
+	}{
+		{"recipes", "recipe"},
+		{"lang:golang", "lang:go"},
+		{"best-practise", "best-practice"},
+		{"completely-unrelated", ""},
+	}
+	for _, tt := range tests {
+		if got := o.Suggest(tt.tag); got != tt.want {
+			t.Errorf("Suggest(%q) = %q, want %q", tt.tag, got, tt.want)
+		}
+	}
+}
+
+func TestLoad_MissingFile(t *testing.T) {
+	_, err := Load(filepath.Join(t.TempDir(), DefaultFileName))
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("Load() error = %v, want fs.ErrNotExist", err)
+	}
+}
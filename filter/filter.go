@@ -7,10 +7,54 @@ import (
 	"gydnc/model"
 )
 
+// metaPrefix marks a filter term as a predicate on a custom frontmatter field instead of a tag.
+const metaPrefix = "meta:"
+
 // FilterOptions defines the options for filtering tags
 type FilterOptions struct {
-	IncludeTags []string // Tags that must be present (can include wildcards)
-	ExcludeTags []string // Tags that must not be present (can include wildcards)
+	IncludeTags []string        // Tags that must be present (can include wildcards)
+	ExcludeTags []string        // Tags that must not be present (can include wildcards)
+	IncludeMeta []MetaPredicate // Custom frontmatter predicates that must hold
+	ExcludeMeta []MetaPredicate // Custom frontmatter predicates that must not hold
+}
+
+// MetaPredicate matches a custom frontmatter field: "meta:tier=must" requires the field
+// tier to equal "must", "meta:tier" only requires it to be present.
+type MetaPredicate struct {
+	Key      string
+	Value    string
+	HasValue bool
+}
+
+// String returns the predicate in filter syntax, e.g. "meta:tier=must".
+func (p MetaPredicate) String() string {
+	if p.HasValue {
+		return metaPrefix + p.Key + "=" + p.Value
+	}
+	return metaPrefix + p.Key
+}
+
+// matchedField returns the entity's field as "key=value" if it satisfies the predicate.
+// Non-string values are compared by their default formatting, e.g. 3 or true.
+func (p MetaPredicate) matchedField(custom map[string]interface{}) (string, bool) {
+	value, ok := custom[p.Key]
+	if !ok {
+		return "", false
+	}
+	formatted := fmt.Sprint(value)
+	if p.HasValue && formatted != p.Value {
+		return "", false
+	}
+	return p.Key + "=" + formatted, true
+}
+
+// parseMetaPredicate parses a term without its "meta:" prefix, e.g. "tier=must" or "tier".
+func parseMetaPredicate(term string) (MetaPredicate, error) {
+	key, value, hasValue := strings.Cut(term, "=")
+	if key == "" {
+		return MetaPredicate{}, fmt.Errorf("invalid metadata filter '%s%s': missing field name", metaPrefix, term)
+	}
+	return MetaPredicate{Key: key, Value: value, HasValue: hasValue}, nil
 }
 
 // Filter represents a compiled filter that can be applied to entities
@@ -23,6 +67,7 @@ type Filter struct {
 // "scope:code quality:safety" (include tags)
 // "NOT deprecated" or "-deprecated" (exclude tags)
 // "scope:* -deprecated" (wildcards and negation)
+// "meta:tier=must -meta:status" (custom frontmatter field equals a value / is present)
 func ParseFilterString(query string) (FilterOptions, error) {
 	options := FilterOptions{}
 
@@ -36,25 +81,41 @@ func ParseFilterString(query string) (FilterOptions, error) {
 	for i := 0; i < len(parts); i++ {
 		part := parts[i]
 
+		exclude := false
 		// Check for NOT operator
 		if part == "NOT" && i+1 < len(parts) {
 			// Next part after NOT should be negated
-			nextPart := parts[i+1]
-			options.ExcludeTags = append(options.ExcludeTags, nextPart)
+			part = parts[i+1]
+			exclude = true
 
 			// Skip the next part since we've processed it
 			i++
-			continue
+		} else if strings.HasPrefix(part, "-") {
+			// Handle exclude with dash prefix
+			part = part[1:]
+			exclude = true
 		}
 
-		// Handle exclude with dash prefix
-		if strings.HasPrefix(part, "-") {
-			options.ExcludeTags = append(options.ExcludeTags, part[1:])
+		// Handle custom frontmatter predicates
+		if strings.HasPrefix(part, metaPrefix) {
+			predicate, err := parseMetaPredicate(part[len(metaPrefix):])
+			if err != nil {
+				return FilterOptions{}, err
+			}
+			if exclude {
+				options.ExcludeMeta = append(options.ExcludeMeta, predicate)
+			} else {
+				options.IncludeMeta = append(options.IncludeMeta, predicate)
+			}
 			continue
 		}
 
-		// Handle include tags
-		options.IncludeTags = append(options.IncludeTags, part)
+		// Handle include and exclude tags
+		if exclude {
+			options.ExcludeTags = append(options.ExcludeTags, part)
+		} else {
+			options.IncludeTags = append(options.IncludeTags, part)
+		}
 	}
 
 	return options, nil
@@ -92,58 +153,146 @@ func (f *Filter) Matches(entity model.Entity) bool {
 		}
 	}
 
+	// Check custom frontmatter predicates
+	for _, predicate := range f.options.IncludeMeta {
+		if _, ok := predicate.matchedField(entity.CustomMetadata); !ok {
+			return false
+		}
+	}
+	for _, predicate := range f.options.ExcludeMeta {
+		if _, ok := predicate.matchedField(entity.CustomMetadata); ok {
+			return false
+		}
+	}
+
 	return true
 }
 
 // containsTag checks if the tag list contains the specified tag,
 // with support for wildcards (e.g., "scope:*", "foo*", "*bar")
 func containsTag(tags []string, searchTag string) bool {
-	// Special case: wildcard only means "has at least one tag"
-	if searchTag == "*" {
-		return len(tags) > 0
+	return len(matchingTags(tags, searchTag)) > 0
+}
+
+// matchingTags returns the tags in the list that match searchTag.
+// A lone "*" matches every tag, i.e. it means "has at least one tag".
+func matchingTags(tags []string, searchTag string) []string {
+	var matched []string
+	for _, tag := range tags {
+		if tagMatches(tag, searchTag) {
+			matched = append(matched, tag)
+		}
 	}
+	return matched
+}
 
-	// Check different wildcard patterns
-	hasPrefixWildcard := strings.HasPrefix(searchTag, "*") && len(searchTag) > 1
-	hasSuffixWildcard := strings.HasSuffix(searchTag, "*") && len(searchTag) > 1 && !strings.HasSuffix(searchTag, ":*")
-	hasNamespaceWildcard := strings.HasSuffix(searchTag, ":*")
+// wildcardKind describes how searchTag is matched: "any", "namespace", "prefix" ("*bar"),
+// "suffix" ("foo*") or "none" for an exact match.
+func wildcardKind(searchTag string) string {
+	switch {
+	case searchTag == "*":
+		return "any"
+	case strings.HasSuffix(searchTag, ":*"):
+		return "namespace"
+	case strings.HasPrefix(searchTag, "*") && len(searchTag) > 1:
+		return "prefix"
+	case strings.HasSuffix(searchTag, "*") && len(searchTag) > 1:
+		return "suffix"
+	default:
+		return "none"
+	}
+}
 
-	// If no wildcards, do exact match
-	if !hasPrefixWildcard && !hasSuffixWildcard && !hasNamespaceWildcard {
-		for _, tag := range tags {
-			if tag == searchTag {
-				return true
-			}
-		}
-		return false
+// tagMatches checks a single tag against searchTag, honouring wildcards.
+func tagMatches(tag string, searchTag string) bool {
+	switch wildcardKind(searchTag) {
+	case "any":
+		return true
+	case "namespace":
+		// Namespace wildcard: "foo:*" matches anything with "foo:" prefix
+		prefix := searchTag[:len(searchTag)-1] // Remove the * but keep the :
+		return strings.HasPrefix(tag, prefix)
+	case "prefix":
+		// Prefix wildcard: "*bar" matches anything ending with "bar"
+		return strings.HasSuffix(tag, searchTag[1:])
+	case "suffix":
+		// Suffix wildcard: "foo*" matches anything starting with "foo"
+		return strings.HasPrefix(tag, searchTag[:len(searchTag)-1])
+	default:
+		return tag == searchTag
 	}
+}
 
-	// Handle wildcard patterns
-	for _, tag := range tags {
-		if hasNamespaceWildcard {
-			// Namespace wildcard: "foo:*" matches anything with "foo:" prefix
-			// Get the namespace prefix (everything up to the :*)
-			prefix := searchTag[:len(searchTag)-1] // Remove the * but keep the :
-			// Make sure it's an exact namespace match by checking for prefix and for ":" at the right position
-			if strings.HasPrefix(tag, prefix) {
-				return true
-			}
-		} else if hasPrefixWildcard {
-			// Prefix wildcard: "*bar" matches anything ending with "bar"
-			suffix := searchTag[1:] // Remove the *
-			if strings.HasSuffix(tag, suffix) {
-				return true
-			}
-		} else if hasSuffixWildcard {
-			// Suffix wildcard: "foo*" matches anything starting with "foo"
-			prefix := searchTag[:len(searchTag)-1] // Remove the *
-			if strings.HasPrefix(tag, prefix) {
-				return true
-			}
-		}
+// TermExplanation describes how a single include or exclude term was evaluated against an entity.
+// For metadata terms, Wildcard is "meta" and MatchedTags holds the matched field as "key=value".
+type TermExplanation struct {
+	Term        string   `json:"term"`
+	Wildcard    string   `json:"wildcard"`
+	MatchedTags []string `json:"matched_tags"`
+	Passed      bool     `json:"passed"` // Include: at least one tag matched. Exclude: no tag matched.
+}
+
+// MatchExplanation describes why an entity matched the filter or not.
+type MatchExplanation struct {
+	Matched bool              `json:"matched"`
+	Include []TermExplanation `json:"include"`
+	Exclude []TermExplanation `json:"exclude"`
+}
+
+// Options returns the parsed options of this filter.
+func (f *Filter) Options() FilterOptions {
+	return f.options
+}
+
+// Explain evaluates every term of the filter against the entity, unlike Matches which stops
+// at the first failing term, so the result shows all reasons an entity matched or didn't.
+func (f *Filter) Explain(entity model.Entity) MatchExplanation {
+	explanation := MatchExplanation{
+		Matched: true,
+		Include: []TermExplanation{},
+		Exclude: []TermExplanation{},
+	}
+	for _, tag := range f.options.IncludeTags {
+		term := explainTerm(entity.Tags, tag)
+		term.Passed = len(term.MatchedTags) > 0
+		explanation.Matched = explanation.Matched && term.Passed
+		explanation.Include = append(explanation.Include, term)
+	}
+	for _, tag := range f.options.ExcludeTags {
+		term := explainTerm(entity.Tags, tag)
+		term.Passed = len(term.MatchedTags) == 0
+		explanation.Matched = explanation.Matched && term.Passed
+		explanation.Exclude = append(explanation.Exclude, term)
 	}
+	for _, predicate := range f.options.IncludeMeta {
+		term := explainMetaTerm(entity.CustomMetadata, predicate)
+		term.Passed = len(term.MatchedTags) > 0
+		explanation.Matched = explanation.Matched && term.Passed
+		explanation.Include = append(explanation.Include, term)
+	}
+	for _, predicate := range f.options.ExcludeMeta {
+		term := explainMetaTerm(entity.CustomMetadata, predicate)
+		term.Passed = len(term.MatchedTags) == 0
+		explanation.Matched = explanation.Matched && term.Passed
+		explanation.Exclude = append(explanation.Exclude, term)
+	}
+	return explanation
+}
 
-	return false
+func explainTerm(tags []string, searchTag string) TermExplanation {
+	matched := matchingTags(tags, searchTag)
+	if matched == nil {
+		matched = []string{}
+	}
+	return TermExplanation{Term: searchTag, Wildcard: wildcardKind(searchTag), MatchedTags: matched}
+}
+
+func explainMetaTerm(custom map[string]interface{}, predicate MetaPredicate) TermExplanation {
+	matched := []string{}
+	if field, ok := predicate.matchedField(custom); ok {
+		matched = append(matched, field)
+	}
+	return TermExplanation{Term: predicate.String(), Wildcard: "meta", MatchedTags: matched}
 }
 
 // Filter applies the filter to a slice of entities and returns only the matching ones
@@ -69,6 +69,15 @@ func TestParseFilterString(t *testing.T) {
 				ExcludeTags: []string{"deprecated"},
 			},
 		},
+		{
+			name:  "Metadata predicates",
+			query: "scope:code meta:tier=must -meta:status NOT meta:type=recipe",
+			expected: FilterOptions{
+				IncludeTags: []string{"scope:code"},
+				IncludeMeta: []MetaPredicate{{Key: "tier", Value: "must", HasValue: true}},
+				ExcludeMeta: []MetaPredicate{{Key: "status"}, {Key: "type", Value: "recipe", HasValue: true}},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -85,8 +94,20 @@ func TestParseFilterString(t *testing.T) {
 			if !reflect.DeepEqual(options.ExcludeTags, tt.expected.ExcludeTags) {
 				t.Errorf("ExcludeTags = %v, want %v", options.ExcludeTags, tt.expected.ExcludeTags)
 			}
+
+			if !reflect.DeepEqual(options.IncludeMeta, tt.expected.IncludeMeta) {
+				t.Errorf("IncludeMeta = %v, want %v", options.IncludeMeta, tt.expected.IncludeMeta)
+			}
+
+			if !reflect.DeepEqual(options.ExcludeMeta, tt.expected.ExcludeMeta) {
+				t.Errorf("ExcludeMeta = %v, want %v", options.ExcludeMeta, tt.expected.ExcludeMeta)
+			}
 		})
 	}
+
+	if _, err := ParseFilterString("meta:=must"); err == nil {
+		t.Errorf("ParseFilterString() with an empty metadata field name succeeded, want an error")
+	}
 }
 
 func TestMatches(t *testing.T) {
@@ -204,6 +225,32 @@ func TestMatches(t *testing.T) {
 			query:    "scope:* -deprecated",
 			expected: []model.Entity{entities[0], entities[1]},
 		},
+		// Tests for custom frontmatter predicates
+		{
+			name:     "Metadata equals",
+			query:    "meta:tier=must",
+			expected: []model.Entity{entities[0]},
+		},
+		{
+			name:     "Metadata not equals",
+			query:    "-meta:tier=must",
+			expected: []model.Entity{entities[1], entities[2], entities[3]},
+		},
+		{
+			name:     "Metadata present",
+			query:    "meta:tier",
+			expected: entities,
+		},
+		{
+			name:     "Metadata absent",
+			query:    "NOT meta:status",
+			expected: entities,
+		},
+		{
+			name:     "Metadata combined with tags",
+			query:    "scope:code meta:type=behavior -meta:tier=must",
+			expected: []model.Entity{entities[2]},
+		},
 	}
 
 	for _, tt := range tests {
@@ -306,3 +353,34 @@ func TestContainsTag(t *testing.T) {
 		t.Errorf("containsTag() with empty tags and wildcard should return false")
 	}
 }
+
+func TestExplain(t *testing.T) {
+	f, err := NewFilterFromString("scope:* quality:safety -deprecated")
+	if err != nil {
+		t.Fatalf("NewFilterFromString() error = %v", err)
+	}
+
+	entity := model.Entity{Alias: "a", Tags: []string{"scope:code", "scope:docs", "deprecated"}}
+	got := f.Explain(entity)
+	want := MatchExplanation{
+		Matched: false,
+		Include: []TermExplanation{
+			{Term: "scope:*", Wildcard: "namespace", MatchedTags: []string{"scope:code", "scope:docs"}, Passed: true},
+			{Term: "quality:safety", Wildcard: "none", MatchedTags: []string{}, Passed: false},
+		},
+		Exclude: []TermExplanation{
+			{Term: "deprecated", Wildcard: "none", MatchedTags: []string{"deprecated"}, Passed: false},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Explain() = %+v, want %+v", got, want)
+	}
+	if got.Matched != f.Matches(entity) {
+		t.Errorf("Explain().Matched = %v, Matches() = %v", got.Matched, f.Matches(entity))
+	}
+
+	matching := model.Entity{Alias: "b", Tags: []string{"scope:code", "quality:safety"}}
+	if explanation := f.Explain(matching); !explanation.Matched || !f.Matches(matching) {
+		t.Errorf("Explain().Matched = %v, Matches() = %v, want both true", explanation.Matched, f.Matches(matching))
+	}
+}
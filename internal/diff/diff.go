@@ -0,0 +1,135 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// op is a single line of an edit script: ' ' (unchanged), '-' (only in a) or '+' (only in b).
+type op struct {
+	kind byte
+	line string
+}
+
+// Lines splits text into lines, dropping the empty element after a trailing newline.
+func Lines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// Unified returns a unified diff of a and b with the given number of context lines,
+// or "" if they are identical. aName and bName are used in the "---"/"+++" headers.
+func Unified(aName, bName string, a, b []string, context int) string {
+	ops := editScript(a, b)
+
+	var changes []int
+	for i, o := range ops {
+		if o.kind != ' ' {
+			changes = append(changes, i)
+		}
+	}
+	if len(changes) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", aName, bName)
+
+	for start := 0; start < len(changes); {
+		// Group changes whose unchanged gap is small enough to share context
+		end := start
+		for end+1 < len(changes) && changes[end+1]-changes[end] <= 2*context+1 {
+			end++
+		}
+		from := max(changes[start]-context, 0)
+		to := min(changes[end]+context+1, len(ops))
+		writeHunk(&sb, ops, from, to)
+		start = end + 1
+	}
+	return sb.String()
+}
+
+// writeHunk writes ops[from:to] as a single hunk with its "@@" header.
+func writeHunk(sb *strings.Builder, ops []op, from, to int) {
+	aLine, bLine := 1, 1
+	for _, o := range ops[:from] {
+		if o.kind != '+' {
+			aLine++
+		}
+		if o.kind != '-' {
+			bLine++
+		}
+	}
+	aCount, bCount := 0, 0
+	for _, o := range ops[from:to] {
+		if o.kind != '+' {
+			aCount++
+		}
+		if o.kind != '-' {
+			bCount++
+		}
+	}
+	fmt.Fprintf(sb, "@@ -%s +%s @@\n", hunkRange(aLine, aCount), hunkRange(bLine, bCount))
+	for _, o := range ops[from:to] {
+		sb.WriteByte(o.kind)
+		sb.WriteString(o.line)
+		sb.WriteByte('\n')
+	}
+}
+
+// hunkRange formats a hunk range like GNU diff: the count is omitted when it is 1,
+// and an empty range refers to the line before it.
+func hunkRange(start, count int) string {
+	switch count {
+	case 0:
+		return fmt.Sprintf("%d,0", start-1)
+	case 1:
+		return fmt.Sprintf("%d", start)
+	default:
+		return fmt.Sprintf("%d,%d", start, count)
+	}
+}
+
+// editScript computes a minimal line edit script from a to b using a longest common subsequence table.
+// Guidance files are small, so the quadratic table is fine.
+func editScript(a, b []string) []op {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	ops := make([]op, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, op{' ', a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, op{'-', a[i]})
+			i++
+		default:
+			ops = append(ops, op{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		ops = append(ops, op{'-', a[i]})
+	}
+	for ; j < len(b); j++ {
+		ops = append(ops, op{'+', b[j]})
+	}
+	return ops
+}
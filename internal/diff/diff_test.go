@@ -0,0 +1,48 @@
+package diff
+
+import "testing"
+
+func TestUnified(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b    string
+		context int
+		want    string
+	}{
+		{
+			name: "identical",
+			a:    "one\ntwo\n",
+			b:    "one\ntwo\n",
+			want: "",
+		},
+		{
+			name:    "single change with context",
+			a:       "1\n2\n3\n4\n5\n6\n7\n8\n",
+			b:       "1\n2\n3\n4\nfive\n6\n7\n8\n",
+			context: 2,
+			want:    "--- a\n+++ b\n@@ -3,5 +3,5 @@\n 3\n 4\n-5\n+five\n 6\n 7\n",
+		},
+		{
+			name:    "distant changes make separate hunks",
+			a:       "1\n2\n3\n4\n5\n6\n7\n8\n9\n",
+			b:       "one\n2\n3\n4\n5\n6\n7\n8\nnine\n",
+			context: 1,
+			want:    "--- a\n+++ b\n@@ -1,2 +1,2 @@\n-1\n+one\n 2\n@@ -8,2 +8,2 @@\n 8\n-9\n+nine\n",
+		},
+		{
+			name:    "addition to empty",
+			a:       "",
+			b:       "new\n",
+			context: 3,
+			want:    "--- a\n+++ b\n@@ -0,0 +1 @@\n+new\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Unified("a", "b", Lines(tt.a), Lines(tt.b), tt.context)
+			if got != tt.want {
+				t.Errorf("Unified() =\n%q\nwant\n%q", got, tt.want)
+			}
+		})
+	}
+}
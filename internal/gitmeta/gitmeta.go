@@ -0,0 +1,117 @@
+// Package gitmeta answers questions about the git repository, if any, that holds a path,
+// by shelling out to the git binary.
+package gitmeta
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// ErrNotRepository is returned when a path is not inside a git work tree, or git is not installed.
+var ErrNotRepository = errors.New("not a git repository")
+
+// Commit is a single entry of a file's history.
+type Commit struct {
+	Hash    string `json:"hash"` // Abbreviated commit hash
+	Subject string `json:"subject"`
+}
+
+// run executes git with args in dir and returns its trimmed stdout.
+func run(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("git %s: %w: %s", strings.Join(args, " "), err, strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// WorkTreeRoot returns the top-level directory of the git work tree containing dir,
+// or ErrNotRepository if dir is not inside one or git is unavailable.
+func WorkTreeRoot(dir string) (string, error) {
+	if _, err := exec.LookPath("git"); err != nil {
+		return "", fmt.Errorf("%w: git executable not found", ErrNotRepository)
+	}
+	root, err := run(dir, "rev-parse", "--show-toplevel")
+	if err != nil || root == "" {
+		return "", ErrNotRepository
+	}
+	return root, nil
+}
+
+// IsInWorkTree reports whether dir is inside a git work tree.
+func IsInWorkTree(dir string) bool {
+	_, err := WorkTreeRoot(dir)
+	return err == nil
+}
+
+// FileLog returns the commits that touched filePath, newest first, following renames.
+// It returns ErrNotRepository if the file's directory is not inside a git work tree.
+func FileLog(filePath string) ([]Commit, error) {
+	dir := filepath.Dir(filePath)
+	if _, err := WorkTreeRoot(dir); err != nil {
+		return nil, err
+	}
+	out, err := run(dir, "log", "--follow", "--format=%h %s", "--", filepath.Base(filePath))
+	if err != nil {
+		return nil, err
+	}
+
+	var commits []Commit
+	for _, line := range strings.Split(out, "\n") {
+		if line == "" {
+			continue
+		}
+		hash, subject, _ := strings.Cut(line, " ")
+		commits = append(commits, Commit{Hash: hash, Subject: subject})
+	}
+	return commits, nil
+}
+
+// isTracked reports whether path is known to git in the repository containing dir.
+func isTracked(dir, path string) bool {
+	_, err := run(dir, "ls-files", "--error-unmatch", "--", path)
+	return err == nil
+}
+
+// CommitPaths stages the given paths (including deletions) and commits only them with message.
+// Paths that neither exist nor are tracked are skipped. It returns committed=false without error
+// if there was nothing to commit, and ErrNotRepository if dir is not inside a git work tree.
+func CommitPaths(dir string, message string, paths ...string) (bool, error) {
+	if _, err := WorkTreeRoot(dir); err != nil {
+		return false, err
+	}
+
+	var pathspecs []string
+	for _, path := range paths {
+		// git resolves relative pathspecs against dir, not the working directory
+		if absPath, err := filepath.Abs(path); err == nil {
+			path = absPath
+		}
+		if _, err := os.Stat(path); err == nil || isTracked(dir, path) {
+			pathspecs = append(pathspecs, path)
+		}
+	}
+	if len(pathspecs) == 0 {
+		return false, nil
+	}
+
+	if _, err := run(dir, append([]string{"add", "-A", "--"}, pathspecs...)...); err != nil {
+		return false, err
+	}
+	// diff --quiet exits non-zero when there are staged changes for these paths
+	if _, err := run(dir, append([]string{"diff", "--cached", "--quiet", "--"}, pathspecs...)...); err == nil {
+		return false, nil
+	}
+	if _, err := run(dir, append([]string{"commit", "-q", "-m", message, "--"}, pathspecs...)...); err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,110 @@
+package gitmeta
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// gitRepo creates a git repository in a temp dir, skipping the test if git is unavailable.
+func gitRepo(t *testing.T) string {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	dir := t.TempDir()
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"config", "user.email", "test@example.com"},
+		{"config", "user.name", "Test"},
+		{"config", "commit.gpgsign", "false"},
+	} {
+		if _, err := run(dir, args...); err != nil {
+			t.Fatalf("git setup failed: %v", err)
+		}
+	}
+	return dir
+}
+
+func commitFile(t *testing.T, dir, name, data, message string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0644); err != nil {
+		t.Fatalf("failed to write %s: %v", name, err)
+	}
+	if _, err := run(dir, "add", name); err != nil {
+		t.Fatalf("git add failed: %v", err)
+	}
+	if _, err := run(dir, "commit", "-q", "-m", message); err != nil {
+		t.Fatalf("git commit failed: %v", err)
+	}
+}
+
+func TestFileLog(t *testing.T) {
+	dir := gitRepo(t)
+	commitFile(t, dir, "rule.g6e", "v1\n", "Add rule")
+	commitFile(t, dir, "other.g6e", "x\n", "Add other")
+	commitFile(t, dir, "rule.g6e", "v2\n", "Reword rule")
+
+	commits, err := FileLog(filepath.Join(dir, "rule.g6e"))
+	if err != nil {
+		t.Fatalf("FileLog() error = %v", err)
+	}
+	if len(commits) != 2 || commits[0].Subject != "Reword rule" || commits[1].Subject != "Add rule" || commits[0].Hash == "" {
+		t.Errorf("FileLog() = %+v, want the two rule commits newest first", commits)
+	}
+	if !IsInWorkTree(dir) {
+		t.Errorf("IsInWorkTree(%q) = false, want true", dir)
+	}
+}
+
+func TestFileLog_NotRepository(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	dir := t.TempDir()
+	if root, err := WorkTreeRoot(dir); err == nil {
+		t.Skipf("temp dir is inside a git work tree (%s)", root)
+	}
+	if _, err := FileLog(filepath.Join(dir, "rule.g6e")); !errors.Is(err, ErrNotRepository) {
+		t.Errorf("FileLog() error = %v, want ErrNotRepository", err)
+	}
+}
+
+func TestCommitPaths(t *testing.T) {
+	dir := gitRepo(t)
+	commitFile(t, dir, "keep.g6e", "keep\n", "Add keep")
+	rule := filepath.Join(dir, "rule.g6e")
+	if err := os.WriteFile(rule, []byte("v1\n"), 0644); err != nil {
+		t.Fatalf("failed to write rule: %v", err)
+	}
+	// An unrelated change that must not be swept into the commit
+	if err := os.WriteFile(filepath.Join(dir, "keep.g6e"), []byte("edited\n"), 0644); err != nil {
+		t.Fatalf("failed to edit keep: %v", err)
+	}
+
+	committed, err := CommitPaths(dir, "save rule", rule, filepath.Join(dir, "rule.g6e.gz"))
+	if err != nil || !committed {
+		t.Fatalf("CommitPaths() = %v, %v; want a commit", committed, err)
+	}
+	if commits, _ := FileLog(rule); len(commits) != 1 || commits[0].Subject != "save rule" {
+		t.Errorf("FileLog() after commit = %+v", commits)
+	}
+	if commits, _ := FileLog(filepath.Join(dir, "keep.g6e")); len(commits) != 1 {
+		t.Errorf("unrelated file was committed: %+v", commits)
+	}
+
+	// Nothing changed: no commit
+	if committed, err := CommitPaths(dir, "save rule again", rule); err != nil || committed {
+		t.Errorf("CommitPaths() without changes = %v, %v; want no commit", committed, err)
+	}
+
+	// Deletions are committed too
+	if err := os.Remove(rule); err != nil {
+		t.Fatalf("failed to remove rule: %v", err)
+	}
+	if committed, err := CommitPaths(dir, "delete rule", rule); err != nil || !committed {
+		t.Errorf("CommitPaths() for a deletion = %v, %v; want a commit", committed, err)
+	}
+}
@@ -0,0 +1,94 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"gydnc/mcp/tools/format"
+	"gydnc/mcp/tools/types"
+	"gydnc/service"
+	"gydnc/storage"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+var GuidanceDeleteTool = &mcp.Tool{
+	Name:        "gydnc_delete",
+	Description: "Delete a guidance entity from the gydnc knowledge base by alias. Deletes from the specified backend, or the default backend if none is given. Deleting an entity that does not exist reports success=false rather than failing.",
+	Annotations: &mcp.ToolAnnotations{
+		ReadOnlyHint: false,
+	},
+}
+
+type GuidanceDeleteInput struct {
+	Alias   string `json:"alias" jsonschema:"the unique identifier of the guidance entity to delete (required)"`
+	Backend string `json:"backend,omitempty" jsonschema:"name of the storage backend to delete from (optional, uses default if not specified)"`
+}
+
+// Use type from the types package
+type GuidanceDeleteOutput = types.GuidanceDeleteOutput
+
+func GuidanceDelete(ctx context.Context, req *mcp.CallToolRequest, input GuidanceDeleteInput) (
+	*mcp.CallToolResult,
+	GuidanceDeleteOutput,
+	error,
+) {
+	if AppContext == nil {
+		return nil, GuidanceDeleteOutput{}, fmt.Errorf("application context not initialized")
+	}
+
+	if input.Alias == "" {
+		return nil, GuidanceDeleteOutput{}, fmt.Errorf("alias is required")
+	}
+
+	backendName := input.Backend
+	if backendName == "" && AppContext.Config != nil {
+		backendName = AppContext.Config.DefaultBackend
+	}
+
+	entityService := service.NewEntityService(AppContext)
+	if err := entityService.DeleteEntity(input.Alias, backendName); err != nil {
+		errorOutput := GuidanceDeleteOutput{
+			Alias:   input.Alias,
+			Backend: backendName,
+			Success: false,
+			Message: err.Error(),
+		}
+		errorMarkdown := format.FormatDeleteOutput(errorOutput)
+		result := &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: errorMarkdown,
+				},
+			},
+		}
+
+		// Entity not found is an expected business logic error, return as success with error content
+		if errors.Is(err, storage.ErrEntityNotFound) || errors.Is(err, fs.ErrNotExist) {
+			return result, errorOutput, nil
+		}
+
+		// For unexpected errors, return as error
+		return result, errorOutput, err
+	}
+
+	result := GuidanceDeleteOutput{
+		Alias:   input.Alias,
+		Backend: backendName,
+		Success: true,
+		Message: fmt.Sprintf("Successfully deleted entity '%s' from backend '%s'", input.Alias, backendName),
+	}
+
+	// Format as markdown using formatter
+	markdown := format.FormatDeleteOutput(result)
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: markdown,
+			},
+		},
+	}, result, nil
+}
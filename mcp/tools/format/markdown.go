@@ -53,6 +53,14 @@ func FormatGetOutput(items []types.GuidanceGetItem) string {
 				markdown.WriteString(fmt.Sprintf("**Tags:** %s\n\n", tagList))
 			}
 
+			if item.Backend != "" {
+				markdown.WriteString(fmt.Sprintf("**Backend:** `%s`\n\n", item.Backend))
+			}
+
+			if item.TokenEstimate > 0 {
+				markdown.WriteString(fmt.Sprintf("**Estimated tokens:** ~%d\n\n", item.TokenEstimate))
+			}
+
 			if item.Body != "" {
 				markdown.WriteString("---\n\n")
 				markdown.WriteString(item.Body)
@@ -103,3 +111,29 @@ func FormatWriteErrorOutput(output types.GuidanceWriteOutput) string {
 
 	return fmt.Sprintf("## ❌ Failed to %s\n\n**Alias:** `%s`\n**Error:** %s\n", action, output.Alias, output.Message)
 }
+
+// FormatDeleteOutput formats a delete operation, successful or not, as markdown
+func FormatDeleteOutput(output types.GuidanceDeleteOutput) string {
+	if !output.Success {
+		return fmt.Sprintf("## ❌ Failed to Delete\n\n**Alias:** `%s`\n**Error:** %s\n", output.Alias, output.Message)
+	}
+	return fmt.Sprintf("## ✅ Successfully Deleted\n\n**Alias:** `%s`\n**Backend:** `%s`\n", output.Alias, output.Backend)
+}
+
+// FormatSearchOutput formats the results of a body search as markdown
+func FormatSearchOutput(query string, items []types.GuidanceSearchItem) string {
+	var markdown strings.Builder
+	markdown.WriteString(fmt.Sprintf("## Found %d guidance entities matching `%s`\n\n", len(items), query))
+
+	for _, item := range items {
+		markdown.WriteString(fmt.Sprintf("### %s\n", item.Title))
+		markdown.WriteString(fmt.Sprintf("**Alias:** `%s`\n", item.Alias))
+		markdown.WriteString(fmt.Sprintf("**Matches:** %d\n", item.MatchCount))
+		for _, match := range item.Matches {
+			markdown.WriteString(fmt.Sprintf("- line %d: %s\n", match.Line, match.Snippet))
+		}
+		markdown.WriteString("\n")
+	}
+
+	return markdown.String()
+}
@@ -3,7 +3,9 @@ package tools
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"gydnc/core/content"
 	"gydnc/mcp/tools/format"
 	"gydnc/mcp/tools/types"
 	"gydnc/service"
@@ -13,7 +15,7 @@ import (
 
 var GuidanceReadTool = &mcp.Tool{
 	Name:        "gydnc_read",
-	Description: "Read guidance entities from the gydnc knowledge base. Supports two operations: 'list' to discover available entities with optional tag filtering, and 'get' to retrieve full content of entities by alias. Use 'list' first to discover what guidance is available, then 'get' to fetch full content. Fetching multiple entities in one 'get' call is more efficient than separate calls.",
+	Description: "Read guidance entities from the gydnc knowledge base. Supports two operations: 'list' to discover available entities with optional tag filtering, and 'get' to retrieve full content of entities by alias. Use 'list' first to discover what guidance is available, then 'get' to fetch full content. Fetching multiple entities in one 'get' call is more efficient than separate calls. Set match_by to 'title' to look entities up by title instead of alias.",
 	Annotations: &mcp.ToolAnnotations{
 		ReadOnlyHint: true,
 	},
@@ -22,12 +24,13 @@ var GuidanceReadTool = &mcp.Tool{
 type GuidanceReadInput struct {
 	Operation  string   `json:"operation" jsonschema:"the operation to perform: 'list' or 'get'"`
 	FilterTags string   `json:"filter_tags,omitempty" jsonschema:"for 'list' operation: tag filter expression (e.g., 'scope:code quality:safety', '-deprecated', 'scope:*')"`
-	Aliases    []string `json:"aliases,omitempty" jsonschema:"for 'get' operation: one or more guidance aliases to retrieve"`
+	Aliases    []string `json:"aliases,omitempty" jsonschema:"for 'get' operation: one or more guidance aliases to retrieve (or titles, with match_by 'title')"`
+	MatchBy    string   `json:"match_by,omitempty" jsonschema:"for 'get' operation: 'alias' (default) or 'title' to look entities up by their exact title (case-insensitive)"`
 }
 
 type GuidanceReadOutput struct {
 	Operation string      `json:"operation" jsonschema:"the operation that was performed"`
-	Entities  interface{} `json:"entities" jsonschema:"list operation returns array of {alias, title, tags}; get operation returns array of {title, description, tags, body}"`
+	Entities  interface{} `json:"entities" jsonschema:"list operation returns array of {alias, title, tags}; get operation returns array of {title, description, tags, body, token_estimate, backend}"`
 }
 
 // Use types from the types package
@@ -49,7 +52,18 @@ func GuidanceRead(ctx context.Context, req *mcp.CallToolRequest, input GuidanceR
 	case "list":
 		return handleListOperation(ctx, entityService, input.FilterTags)
 	case "get":
-		return handleGetOperation(ctx, entityService, input.Aliases)
+		switch input.MatchBy {
+		case "", "alias":
+			return handleGetOperation(ctx, entityService, input.Aliases, nil)
+		case "title":
+			if len(input.Aliases) == 0 {
+				return nil, GuidanceReadOutput{}, fmt.Errorf("at least one title must be provided for 'get' operation with match_by 'title'")
+			}
+			aliases, lookupErrors := resolveTitlesToAliases(entityService, input.Aliases)
+			return handleGetOperation(ctx, entityService, aliases, lookupErrors)
+		default:
+			return nil, GuidanceReadOutput{}, fmt.Errorf("invalid match_by '%s': must be 'alias' or 'title'", input.MatchBy)
+		}
 	default:
 		return nil, GuidanceReadOutput{}, fmt.Errorf("invalid operation '%s': must be 'list' or 'get'", input.Operation)
 	}
@@ -83,18 +97,52 @@ func handleListOperation(ctx context.Context, entityService *service.EntityServi
 	markdown := format.FormatListOutput(items)
 
 	return &mcp.CallToolResult{
-			Content: []mcp.Content{
-				&mcp.TextContent{
-					Text: markdown,
-				},
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: markdown,
 			},
-		}, GuidanceReadOutput{
-			Operation: "list",
-			Entities:  items,
-		}, nil
+		},
+	}, GuidanceReadOutput{
+		Operation: "list",
+		Entities:  items,
+	}, nil
+}
+
+// resolveTitlesToAliases maps each title to the alias of the entity with that title (case-insensitive),
+// using the merged entity list. Titles matching no entity or several entities are returned unchanged,
+// with the reason recorded in the returned error map keyed by position.
+func resolveTitlesToAliases(entityService *service.EntityService, titles []string) ([]string, map[int]error) {
+	entities, backendErrors := entityService.ListEntitiesMerged("", "")
+	for backendName, err := range backendErrors {
+		AppContext.Logger.Warn("Error accessing backend during title lookup", "backend", backendName, "error", err)
+	}
+
+	aliasesByTitle := make(map[string][]string)
+	for _, entity := range entities {
+		key := strings.ToLower(strings.TrimSpace(entity.Title))
+		aliasesByTitle[key] = append(aliasesByTitle[key], entity.Alias)
+	}
+
+	resolved := make([]string, len(titles))
+	lookupErrors := make(map[int]error)
+	for i, title := range titles {
+		resolved[i] = title
+		candidates := aliasesByTitle[strings.ToLower(strings.TrimSpace(title))]
+		switch len(candidates) {
+		case 1:
+			resolved[i] = candidates[0]
+		case 0:
+			lookupErrors[i] = fmt.Errorf("no entity with title '%s'", title)
+		default:
+			lookupErrors[i] = fmt.Errorf("title '%s' is ambiguous; candidate aliases: %s", title, strings.Join(candidates, ", "))
+		}
+	}
+	return resolved, lookupErrors
 }
 
-func handleGetOperation(ctx context.Context, entityService *service.EntityService, aliases []string) (
+// handleGetOperation fetches the given aliases. Positions present in lookupErrors could not be
+// resolved to an alias (see resolveTitlesToAliases) and are reported as error items.
+func handleGetOperation(ctx context.Context, entityService *service.EntityService, aliases []string, lookupErrors map[int]error) (
 	*mcp.CallToolResult,
 	GuidanceReadOutput,
 	error,
@@ -105,7 +153,18 @@ func handleGetOperation(ctx context.Context, entityService *service.EntityServic
 
 	items := make([]GuidanceGetItem, 0, len(aliases))
 
-	for _, alias := range aliases {
+	// Provenance only matters when the same alias can come from more than one backend
+	showBackend := AppContext.Config != nil && len(AppContext.Config.StorageBackends) > 1
+
+	for i, alias := range aliases {
+		if err, ok := lookupErrors[i]; ok {
+			items = append(items, GuidanceGetItem{
+				Title: fmt.Sprintf("ERROR_FETCHING_CONTENT_FOR_%s", alias),
+				Body:  fmt.Sprintf("Error: %v", err),
+			})
+			continue
+		}
+
 		entity, err := entityService.GetEntity(alias, "")
 		if err != nil {
 			// Continue with other entities even if one fails
@@ -118,25 +177,30 @@ func handleGetOperation(ctx context.Context, entityService *service.EntityServic
 			continue
 		}
 
-		items = append(items, GuidanceGetItem{
-			Title:       entity.Title,
-			Description: entity.Description,
-			Tags:        entity.Tags,
-			Body:        entity.Body,
-		})
+		item := GuidanceGetItem{
+			Title:         entity.Title,
+			Description:   entity.Description,
+			Tags:          entity.Tags,
+			Body:          entity.Body,
+			TokenEstimate: content.EstimateTokens(entity.Body),
+		}
+		if showBackend {
+			item.Backend = entity.SourceBackend
+		}
+		items = append(items, item)
 	}
 
 	// Format as markdown using formatter
 	markdown := format.FormatGetOutput(items)
 
 	return &mcp.CallToolResult{
-			Content: []mcp.Content{
-				&mcp.TextContent{
-					Text: markdown,
-				},
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: markdown,
 			},
-		}, GuidanceReadOutput{
-			Operation: "get",
-			Entities:  items,
-		}, nil
+		},
+	}, GuidanceReadOutput{
+		Operation: "get",
+		Entities:  items,
+	}, nil
 }
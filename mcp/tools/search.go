@@ -0,0 +1,80 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"gydnc/mcp/tools/format"
+	"gydnc/mcp/tools/types"
+	"gydnc/service"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+var GuidanceSearchTool = &mcp.Tool{
+	Name:        "gydnc_search",
+	Description: "Search the bodies of guidance entities in the gydnc knowledge base. Returns matching aliases with a few snippets of context per entity, without full content. Use it to find the right guidance before fetching it with gydnc_read 'get'. The query is a case-insensitive substring unless 'regex' is true, in which case it is a Go regular expression. Optionally narrow the search with a tag filter expression.",
+	Annotations: &mcp.ToolAnnotations{
+		ReadOnlyHint: true,
+	},
+}
+
+type GuidanceSearchInput struct {
+	Query      string `json:"query" jsonschema:"the text to search for in entity bodies (required)"`
+	FilterTags string `json:"filter_tags,omitempty" jsonschema:"tag filter expression to narrow the search (e.g., 'scope:code', '-deprecated', 'lang:*')"`
+	Regex      bool   `json:"regex,omitempty" jsonschema:"treat query as a Go regular expression instead of a case-insensitive substring"`
+}
+
+type GuidanceSearchOutput struct {
+	Query    string                     `json:"query" jsonschema:"the query that was searched for"`
+	Entities []types.GuidanceSearchItem `json:"entities" jsonschema:"array of {alias, title, match_count, matches}"`
+}
+
+func GuidanceSearch(ctx context.Context, req *mcp.CallToolRequest, input GuidanceSearchInput) (
+	*mcp.CallToolResult,
+	GuidanceSearchOutput,
+	error,
+) {
+	if AppContext == nil {
+		return nil, GuidanceSearchOutput{}, fmt.Errorf("application context not initialized")
+	}
+
+	entityService := service.NewEntityService(AppContext)
+	results, backendErrors, err := entityService.SearchEntities(input.Query, input.FilterTags, input.Regex)
+	if err != nil {
+		return nil, GuidanceSearchOutput{}, err
+	}
+
+	// Log backend errors but don't fail the request
+	for backendName, err := range backendErrors {
+		AppContext.Logger.Warn("Error accessing backend during search operation", "backend", backendName, "error", err)
+	}
+
+	items := make([]types.GuidanceSearchItem, len(results))
+	for i, result := range results {
+		matches := make([]types.GuidanceSearchMatch, len(result.Matches))
+		for j, match := range result.Matches {
+			matches[j] = types.GuidanceSearchMatch{Line: match.Line, Snippet: match.Snippet}
+		}
+		items[i] = types.GuidanceSearchItem{
+			Alias:      result.Alias,
+			Title:      result.Title,
+			MatchCount: result.MatchCount,
+			Matches:    matches,
+		}
+	}
+
+	// Format as markdown using formatter
+	markdown := format.FormatSearchOutput(input.Query, items)
+
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: markdown,
+			},
+		},
+	}, GuidanceSearchOutput{
+		Query:    input.Query,
+		Entities: items,
+	}, nil
+}
@@ -20,5 +20,7 @@ func init() {
 		}, nil)
 
 	mcp.AddTool(Server, GuidanceReadTool, GuidanceRead)
+	mcp.AddTool(Server, GuidanceSearchTool, GuidanceSearch)
 	mcp.AddTool(Server, GuidanceWriteTool, GuidanceWrite)
+	mcp.AddTool(Server, GuidanceDeleteTool, GuidanceDelete)
 }
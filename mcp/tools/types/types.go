@@ -9,10 +9,12 @@ type GuidanceListItem struct {
 
 // GuidanceGetItem represents a guidance entity in get operations
 type GuidanceGetItem struct {
-	Title       string   `json:"title" jsonschema:"the title of the guidance entity"`
-	Description string   `json:"description,omitempty" jsonschema:"the description of the guidance entity"`
-	Tags        []string `json:"tags" jsonschema:"tags associated with the guidance entity"`
-	Body        string   `json:"body" jsonschema:"the full body content of the guidance entity"`
+	Title         string   `json:"title" jsonschema:"the title of the guidance entity"`
+	Description   string   `json:"description,omitempty" jsonschema:"the description of the guidance entity"`
+	Tags          []string `json:"tags" jsonschema:"tags associated with the guidance entity"`
+	Body          string   `json:"body" jsonschema:"the full body content of the guidance entity"`
+	TokenEstimate int      `json:"token_estimate" jsonschema:"approximate number of tokens in the body (heuristic estimate, not an exact count)"`
+	Backend       string   `json:"backend,omitempty" jsonschema:"the storage backend the entity was read from"`
 }
 
 // GuidanceWriteOutput represents the output of write operations
@@ -23,3 +25,25 @@ type GuidanceWriteOutput struct {
 	Success   bool   `json:"success" jsonschema:"whether the operation succeeded"`
 	Message   string `json:"message,omitempty" jsonschema:"optional message about the operation"`
 }
+
+// GuidanceDeleteOutput represents the output of delete operations
+type GuidanceDeleteOutput struct {
+	Alias   string `json:"alias" jsonschema:"the alias of the entity that was deleted"`
+	Backend string `json:"backend,omitempty" jsonschema:"the backend the entity was deleted from"`
+	Success bool   `json:"success" jsonschema:"whether the operation succeeded"`
+	Message string `json:"message,omitempty" jsonschema:"optional message about the operation"`
+}
+
+// GuidanceSearchMatch represents one matching body line in search operations
+type GuidanceSearchMatch struct {
+	Line    int    `json:"line" jsonschema:"the 1-based line number of the match within the body"`
+	Snippet string `json:"snippet" jsonschema:"the matching line, trimmed around the match if long"`
+}
+
+// GuidanceSearchItem represents a guidance entity whose body matched a search
+type GuidanceSearchItem struct {
+	Alias      string                `json:"alias" jsonschema:"the unique identifier for the guidance entity"`
+	Title      string                `json:"title" jsonschema:"the title of the guidance entity"`
+	MatchCount int                   `json:"match_count" jsonschema:"the number of matching body lines"`
+	Matches    []GuidanceSearchMatch `json:"matches" jsonschema:"up to the first few matching lines with context"`
+}
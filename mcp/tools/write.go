@@ -16,20 +16,23 @@ import (
 
 var GuidanceWriteTool = &mcp.Tool{
 	Name:        "gydnc_write",
-	Description: "Write (create or update) guidance entities in the gydnc knowledge base. Supports two operations: 'create' to add a new entity, and 'update' to modify an existing entity. Both operations share the same parameter structure: alias (required), title, description, tags, and body (all optional). For 'update', only provided fields will be modified; existing values are preserved for omitted fields.",
+	Description: "Write (create or update) guidance entities in the gydnc knowledge base. Supports two operations: 'create' to add a new entity, and 'update' to modify an existing entity. Both operations share the same parameter structure: alias (required), title, description, tags, and body (all optional). For 'update', only fields present in the request are modified; omitted fields keep their existing values, and an explicit empty string or empty tags array clears the field.",
 	Annotations: &mcp.ToolAnnotations{
 		ReadOnlyHint: false,
 	},
 }
 
+// GuidanceWriteInput uses pointer fields for the entity attributes so that an
+// omitted field (nil) can be told apart from an explicitly empty one. For
+// 'update', nil means "keep the existing value" and an empty value clears it.
 type GuidanceWriteInput struct {
-	Operation   string   `json:"operation" jsonschema:"the operation to perform: 'create' or 'update'"`
-	Alias       string   `json:"alias" jsonschema:"the unique identifier for the guidance entity (required)"`
-	Title       string   `json:"title,omitempty" jsonschema:"the title of the guidance entity (optional, for update: empty string means don't update)"`
-	Description string   `json:"description,omitempty" jsonschema:"the description of the guidance entity (optional, for update: empty string means don't update)"`
-	Tags        []string `json:"tags,omitempty" jsonschema:"tags associated with the guidance entity (optional, for update: empty array means don't update)"`
-	Body        string   `json:"body,omitempty" jsonschema:"the body content of the guidance entity (optional, for update: empty string means don't update)"`
-	Backend     string   `json:"backend,omitempty" jsonschema:"name of the storage backend to use (optional, uses default if not specified)"`
+	Operation   string    `json:"operation" jsonschema:"the operation to perform: 'create' or 'update'"`
+	Alias       string    `json:"alias" jsonschema:"the unique identifier for the guidance entity (required)"`
+	Title       *string   `json:"title,omitempty" jsonschema:"the title of the guidance entity (optional, for update: omit to keep, empty string to clear)"`
+	Description *string   `json:"description,omitempty" jsonschema:"the description of the guidance entity (optional, for update: omit to keep, empty string to clear)"`
+	Tags        *[]string `json:"tags,omitempty" jsonschema:"tags associated with the guidance entity (optional, for update: omit to keep, empty array to clear)"`
+	Body        *string   `json:"body,omitempty" jsonschema:"the body content of the guidance entity (optional, for update: omit to keep, empty string to clear)"`
+	Backend     string    `json:"backend,omitempty" jsonschema:"name of the storage backend to use (optional, uses default if not specified)"`
 }
 
 // Use type from the types package
@@ -68,15 +71,17 @@ func handleCreateOperation(ctx context.Context, entityService *service.EntitySer
 	// Build entity for creation
 	entity := model.Entity{
 		Alias:       input.Alias,
-		Title:       input.Title,
-		Description: input.Description,
-		Tags:        input.Tags,
-		Body:        input.Body,
+		Title:       stringValue(input.Title),
+		Description: stringValue(input.Description),
+		Body:        stringValue(input.Body),
+	}
+	if input.Tags != nil {
+		entity.Tags = *input.Tags
 	}
 
 	// Provide default body if none specified (matching CLI behavior)
 	// Note: We check for empty string, but preserve whitespace-only strings
-	if input.Body == "" {
+	if entity.Body == "" {
 		if entity.Title == "" {
 			entity.Body = "#\n\nGuidance content for '' goes here.\n"
 		} else {
@@ -163,21 +168,20 @@ func handleUpdateOperation(ctx context.Context, entityService *service.EntitySer
 		}, errorOutput, nil
 	}
 
-	// Apply updates only to provided fields (empty string means "don't update")
-	// Note: We check for empty string, but preserve whitespace-only strings
-	// Empty string == not provided, so we only update if the field is non-empty
-	if input.Title != "" {
-		existingEntity.Title = input.Title
+	// Apply updates only to fields present in the input (nil means "don't update").
+	// A present-but-empty value clears the field.
+	if input.Title != nil {
+		existingEntity.Title = *input.Title
 	}
-	if input.Description != "" {
-		existingEntity.Description = input.Description
+	if input.Description != nil {
+		existingEntity.Description = *input.Description
 	}
-	// For tags, empty array means "don't update", non-empty array means "replace all tags"
-	if len(input.Tags) > 0 {
-		existingEntity.Tags = input.Tags
+	// For tags, a present array replaces all tags; an empty array removes them
+	if input.Tags != nil {
+		existingEntity.Tags = *input.Tags
 	}
-	if input.Body != "" {
-		existingEntity.Body = input.Body
+	if input.Body != nil {
+		existingEntity.Body = *input.Body
 	}
 
 	// Use the entity's source backend for update (or override if specified)
@@ -225,3 +229,11 @@ func handleUpdateOperation(ctx context.Context, entityService *service.EntitySer
 		},
 	}, result, nil
 }
+
+// stringValue dereferences an optional string input, treating nil as empty.
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
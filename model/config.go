@@ -1,5 +1,13 @@
 package model
 
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
 // IMPORTANT: Configuration Backward Compatibility Notice
 //
 // The YAML config format (config.yml) is considered "extend only" for backward compatibility.
@@ -11,7 +19,19 @@ package model
 //
 // @stable: This structure must not be renamed or have fields removed
 type LocalFSConfig struct {
-	Path string `yaml:"path" json:"path"` // @stable: Required field
+	Path     string   `yaml:"path" json:"path"`                             // @stable: Required field
+	Ignore   []string `yaml:"ignore,omitempty" json:"ignore,omitempty"`     // Glob patterns (filepath.Match) for files/aliases to skip
+	Compress bool     `yaml:"compress,omitempty" json:"compress,omitempty"` // Store entities gzipped as .g6e.gz on write
+	// GitAutocommit commits each written or deleted entity file when the path is inside a git work tree
+	GitAutocommit bool `yaml:"git_autocommit,omitempty" json:"git_autocommit,omitempty"`
+	// LockTimeout is how long a write or delete waits for another process's lock on the same
+	// entity, as a Go duration (e.g. "10s"); empty means the default of 5s
+	LockTimeout string `yaml:"lock_timeout,omitempty" json:"lock_timeout,omitempty"`
+}
+
+// InMemConfig defines the configuration for an in-memory (inmem) storage backend.
+type InMemConfig struct {
+	SeedDir string `yaml:"seed_dir,omitempty" json:"seed_dir,omitempty"` // Directory of .g6e files loaded at init; relative to the config file
 }
 
 // StorageConfig defines the configuration for a storage backend.
@@ -22,9 +42,28 @@ type LocalFSConfig struct {
 type StorageConfig struct {
 	Type    string         `yaml:"type" json:"type"`                 // e.g., "localfs" @stable: Required field
 	LocalFS *LocalFSConfig `yaml:"localfs,omitempty" json:"localfs"` // Pointer to allow omitempty @stable
+	InMem   *InMemConfig   `yaml:"inmem,omitempty" json:"inmem,omitempty"`
 	// Other backend types like S3Config, DBConfig etc. would go here
 }
 
+// Supported StorageConfig.Type values.
+const (
+	BackendTypeLocalFS = "localfs"
+	BackendTypeInMem   = "inmem"
+)
+
+// SupportedBackendTypes lists every StorageConfig.Type the storage factory can create. It is
+// shared by config validation and 'gydnc init' so the three cannot drift apart.
+var SupportedBackendTypes = []string{BackendTypeLocalFS, BackendTypeInMem}
+
+// IsSupportedBackendType reports whether backendType is one of SupportedBackendTypes.
+func IsSupportedBackendType(backendType string) bool {
+	return slices.Contains(SupportedBackendTypes, backendType)
+}
+
+// AliasCaseLower is the Config.NormalizeAliasCase value that lowercases aliases.
+const AliasCaseLower = "lower"
+
 // Config defines the structure of the gydnc.conf file.
 // It supports multiple named storage backends.
 //
@@ -33,9 +72,63 @@ type StorageConfig struct {
 type Config struct {
 	DefaultBackend  string                    `yaml:"default_backend" json:"default_backend"`
 	StorageBackends map[string]*StorageConfig `yaml:"storage_backends" json:"storage_backends"`
+	// ListConcurrency bounds the number of concurrent backend reads when listing; 0 means runtime.NumCPU().
+	ListConcurrency int `yaml:"list_concurrency,omitempty" json:"list_concurrency,omitempty"`
+	// NormalizeAliasCase set to AliasCaseLower lowercases aliases on write and resolves them
+	// case-insensitively on read; empty (the default) keeps aliases as given.
+	NormalizeAliasCase string `yaml:"normalize_alias_case,omitempty" json:"normalize_alias_case,omitempty"`
+	// BackendPriority orders backends when an alias exists in several of them: listed backends
+	// win in the given order, then the default backend, then the rest by name.
+	BackendPriority []string `yaml:"backend_priority,omitempty" json:"backend_priority,omitempty"`
 	// Future global settings can go here, e.g., relating to canonicalization or hashing defaults
 	// Canonicalization struct {
 	// 	 HashAlgorithm string   `yaml:"hash_algorithm"`
 	// 	 IncludeFields []string `yaml:"include_fields"`
 	// } `yaml:"canonicalization"`
 }
+
+// Validate checks the configuration for mistakes that would otherwise only surface later as
+// backend initialization errors: a default_backend that is not defined, backends with an
+// empty name, a missing or unsupported type, or a localfs backend without a path or with an
+// invalid lock_timeout. It returns one error per problem, in backend name order, or nil if
+// the configuration is valid.
+// An empty default_backend is allowed.
+func (c *Config) Validate() []error {
+	var errs []error
+	if c.DefaultBackend != "" {
+		if _, ok := c.StorageBackends[c.DefaultBackend]; !ok {
+			errs = append(errs, fmt.Errorf("default_backend '%s' is not defined in storage_backends", c.DefaultBackend))
+		}
+	}
+
+	names := make([]string, 0, len(c.StorageBackends))
+	for name := range c.StorageBackends {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		backendCfg := c.StorageBackends[name]
+		if name == "" {
+			errs = append(errs, fmt.Errorf("storage_backends contains a backend with an empty name"))
+			continue
+		}
+		if backendCfg == nil {
+			errs = append(errs, fmt.Errorf("backend '%s' has no configuration", name))
+			continue
+		}
+		switch {
+		case backendCfg.Type == "":
+			errs = append(errs, fmt.Errorf("backend '%s' has no type (supported: %s)", name, strings.Join(SupportedBackendTypes, ", ")))
+		case !IsSupportedBackendType(backendCfg.Type):
+			errs = append(errs, fmt.Errorf("backend '%s' has unsupported type '%s' (supported: %s)", name, backendCfg.Type, strings.Join(SupportedBackendTypes, ", ")))
+		case backendCfg.Type == BackendTypeLocalFS && (backendCfg.LocalFS == nil || backendCfg.LocalFS.Path == ""):
+			errs = append(errs, fmt.Errorf("localfs backend '%s' has an empty path; set storage_backends.%s.localfs.path", name, name))
+		case backendCfg.Type == BackendTypeLocalFS && backendCfg.LocalFS.LockTimeout != "":
+			if timeout, err := time.ParseDuration(backendCfg.LocalFS.LockTimeout); err != nil || timeout < 0 {
+				errs = append(errs, fmt.Errorf("localfs backend '%s' has invalid lock_timeout '%s'; use a duration such as 10s", name, backendCfg.LocalFS.LockTimeout))
+			}
+		}
+	}
+	return errs
+}
@@ -1,21 +1,36 @@
 package model
 
+import "time"
+
 // Entity represents a single guidance entity as listed or retrieved from a backend.
 // It provides key metadata for quick assessment, filtering, and internal operations.
 type Entity struct {
-	Alias          string                 `json:"alias"`                     // Human-readable alias (e.g., from filename)
-	SourceBackend  string                 `json:"source_backend"`            // Name of the backend this item came from
-	Title          string                 `json:"title,omitempty"`           // From 'title' field in frontmatter
-	Description    string                 `json:"description,omitempty"`     // From 'description' field in frontmatter
-	Tags           []string               `json:"tags,omitempty"`            // From 'tags' field in frontmatter
-	CustomMetadata map[string]interface{} `json:"custom_metadata,omitempty"` // All other frontmatter fields
-	Body           string                 `json:"body,omitempty"`            // The body content of the guidance, after frontmatter
+	Alias          string                 `json:"alias" yaml:"alias"`                                         // Human-readable alias (e.g., from filename)
+	SourceBackend  string                 `json:"source_backend" yaml:"source_backend"`                       // Name of the backend this item came from
+	Title          string                 `json:"title,omitempty" yaml:"title,omitempty"`                     // From 'title' field in frontmatter
+	Description    string                 `json:"description,omitempty" yaml:"description,omitempty"`         // From 'description' field in frontmatter
+	Tags           []string               `json:"tags,omitempty" yaml:"tags,omitempty"`                       // From 'tags' field in frontmatter
+	CustomMetadata map[string]interface{} `json:"custom_metadata,omitempty" yaml:"custom_metadata,omitempty"` // All other frontmatter fields
+	Body           string                 `json:"body,omitempty" yaml:"body,omitempty"`                       // The body content of the guidance, after frontmatter
+
+	// Warnings lists problems met while reading the entity leniently (e.g. malformed frontmatter),
+	// meaning the other fields may be incomplete
+	Warnings []string `json:"warnings,omitempty" yaml:"warnings,omitempty"`
 
 	// Content ID - a deterministic hash of the content
 	// Used for conflict detection and resolution
-	CID string `json:"-"` // Internal content ID, not surfaced in CLI output
+	CID string `json:"-" yaml:"-"` // Internal content ID, not surfaced in CLI output
+
+	// ModTime is when the entity was last modified, for backends whose Stat reports a mod_time
+	// (localfs); zero otherwise
+	ModTime time.Time `json:"-" yaml:"-"`
+
+	// Path (absolute) and RelPath (relative to the backend's base directory) locate the entity's
+	// file, for backends that store entities as files (localfs); empty otherwise
+	Path    string `json:"-" yaml:"-"`
+	RelPath string `json:"-" yaml:"-"`
 
-	// Parent Content ID - points to the previous version of this entity
+	// Parent Content ID - the CID of the version this one replaced (see get --extended)
 	// Used for conflict resolution and history tracking
-	PCID string `json:"-"` // Parent content ID, not surfaced in CLI output
+	PCID string `json:"-" yaml:"-"` // Parent content ID, stored as "pcid" frontmatter; empty until first overwritten
 }
@@ -2,8 +2,10 @@ package service
 
 import (
 	"fmt"
+	"log/slog"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"gydnc/model"
 	"gydnc/util"
@@ -24,8 +26,16 @@ func NewConfigService(ctx *AppContext) *ConfigService {
 
 // InitConfig initializes a new configuration in the specified directory.
 // Returns the path to the .gydnc directory and its config file.
-// If the configuration already exists, it returns an error unless forceCreate is true.
+// If the configuration already exists, or a parent directory already contains a guidance
+// store, it returns an error unless forceCreate is true.
+//
+// backendType must be one of model.SupportedBackendTypes. A localfs backend stores entities in
+// the .gydnc directory; an inmem backend is read-only and seeded from the .g6e files there.
 func (s *ConfigService) InitConfig(targetDir string, backendType string, forceCreate bool) (string, error) {
+	if !model.IsSupportedBackendType(backendType) {
+		return "", fmt.Errorf("unsupported backend type '%s' (supported: %s)", backendType, strings.Join(model.SupportedBackendTypes, ", "))
+	}
+
 	if targetDir == "" {
 		var err error
 		targetDir, err = os.Getwd()
@@ -39,6 +49,9 @@ func (s *ConfigService) InitConfig(targetDir string, backendType string, forceCr
 	if _, err := os.Stat(gydncPath); err == nil && !forceCreate {
 		return "", fmt.Errorf("guidance store already exists at %s", gydncPath)
 	}
+	if parentConfig, found := FindParentStore(targetDir); found && !forceCreate {
+		return "", fmt.Errorf("a guidance store already exists in a parent directory (%s); use it with GYDNC_CONFIG=%s, or pass --force to create a nested store", filepath.Dir(parentConfig), parentConfig)
+	}
 
 	if err := os.MkdirAll(gydncPath, 0755); err != nil {
 		return "", fmt.Errorf("failed to create directory %s: %w", gydncPath, err)
@@ -55,10 +68,16 @@ func (s *ConfigService) InitConfig(targetDir string, backendType string, forceCr
 		Type: backendType,
 	}
 
-	if backendType == "localfs" {
+	switch backendType {
+	case model.BackendTypeLocalFS:
 		storageConfig.LocalFS = &model.LocalFSConfig{
 			Path: gydncPath,
 		}
+	case model.BackendTypeInMem:
+		// seed_dir is relative to the config file, i.e. the .gydnc directory itself
+		storageConfig.InMem = &model.InMemConfig{
+			SeedDir: ".",
+		}
 	}
 
 	cfg.StorageBackends["default_local"] = storageConfig
@@ -72,8 +91,46 @@ func (s *ConfigService) InitConfig(targetDir string, backendType string, forceCr
 	return gydncPath, nil
 }
 
+// FindParentStore looks for a .gydnc/config.yml in the ancestors of dir (not in dir itself)
+// and returns the path of the nearest one.
+func FindParentStore(dir string) (string, bool) {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", false
+	}
+	return findStoreConfig(filepath.Dir(absDir), "")
+}
+
+// DiscoverConfigPath looks for a .gydnc/config.yml in dir and its ancestors, like git looks
+// for .git, and returns the path of the nearest one. The search stops at the filesystem root
+// or at the user's home directory, whichever comes first; the home directory itself is checked.
+func DiscoverConfigPath(dir string) (string, bool) {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", false
+	}
+	home, _ := os.UserHomeDir()
+	return findStoreConfig(absDir, home)
+}
+
+// findStoreConfig walks up from dir looking for .gydnc/config.yml, stopping after stopDir
+// (if non-empty) or the filesystem root.
+func findStoreConfig(dir string, stopDir string) (string, bool) {
+	for current := dir; ; current = filepath.Dir(current) {
+		configPath := filepath.Join(current, ".gydnc", "config.yml")
+		if info, err := os.Stat(configPath); err == nil && !info.IsDir() {
+			return configPath, true
+		}
+		if current == stopDir || filepath.Dir(current) == current {
+			return "", false
+		}
+	}
+}
+
 // GetEffectiveConfigPath determines which configuration file to use based on the provided path
 // or environment variables. If a directory is provided, it appends "config.yml" to the path.
+// If neither is set, the nearest .gydnc/config.yml found by DiscoverConfigPath from the
+// current directory is used.
 func (s *ConfigService) GetEffectiveConfigPath(cliConfigPath string) (string, error) {
 	if cliConfigPath != "" {
 		// Check if the path is a directory, and if so, append config.yml
@@ -93,8 +150,15 @@ func (s *ConfigService) GetEffectiveConfigPath(cliConfigPath string) (string, er
 		return envConfig, nil
 	}
 
+	// Discover a store in the current directory or its ancestors
+	if cwd, err := os.Getwd(); err == nil {
+		if discovered, found := DiscoverConfigPath(cwd); found {
+			return discovered, nil
+		}
+	}
+
 	// No configuration path available
-	return "", fmt.Errorf("no config file specified via CLI or GYDNC_CONFIG environment variable")
+	return "", fmt.Errorf("no config file specified via CLI or GYDNC_CONFIG environment variable, and no .gydnc/config.yml found in the current directory or its parents")
 }
 
 // LoadConfig loads configuration from the specified path.
@@ -110,20 +174,141 @@ func (s *ConfigService) LoadConfig(configPath string, requireConfig bool) (*mode
 }
 
 // LoadFromPath loads configuration from a specific file path.
+//
+// configFilePath may also be an OS path list (colon-separated on Unix), e.g. a shared base
+// config followed by a per-project overlay. The files are loaded in order and merged left to
+// right with MergeConfig. Relative backend paths in each file are made absolute against that
+// file's directory first, so they resolve the same way as when the file is used on its own.
+//
+// The loaded (merged) configuration is checked with Config.Validate and each problem is
+// logged as a warning naming the config path.
 func (s *ConfigService) LoadFromPath(configFilePath string, requireConfig bool) (*model.Config, error) {
 	// If no configuration file path is provided, always return an error
 	if configFilePath == "" {
 		return nil, fmt.Errorf("no config file found - configuration must be explicitly provided via CLI arg or GYDNC_CONFIG env var")
 	}
 
+	if paths := filepath.SplitList(configFilePath); len(paths) > 1 {
+		var merged *model.Config
+		for _, path := range paths {
+			if path == "" {
+				continue
+			}
+			if info, err := os.Stat(path); err == nil && info.IsDir() {
+				path = filepath.Join(path, "config.yml")
+			}
+			cfg, err := s.loadConfigFile(path)
+			if err != nil {
+				return nil, err
+			}
+			absolutizeBackendPaths(cfg, filepath.Dir(path))
+			merged = MergeConfig(merged, cfg)
+		}
+		if merged == nil {
+			return nil, fmt.Errorf("no config file found in config path list '%s'", configFilePath)
+		}
+		reportInvalidConfig(merged, configFilePath)
+		return merged, nil
+	}
+
+	cfg, err := s.loadConfigFile(configFilePath)
+	if err != nil {
+		return nil, err
+	}
+	reportInvalidConfig(cfg, configFilePath)
+	return cfg, nil
+}
+
+// loadConfigFile reads and parses a single config file without validating it.
+func (s *ConfigService) loadConfigFile(configFilePath string) (*model.Config, error) {
 	data, err := os.ReadFile(configFilePath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read config file %s: %w", configFilePath, err)
 	}
-
 	return s.LoadConfigFromString(string(data))
 }
 
+// reportInvalidConfig logs a warning for each problem found by cfg.Validate. The config is
+// still used, so commands such as 'backends' can help diagnose it.
+func reportInvalidConfig(cfg *model.Config, configFilePath string) {
+	for _, err := range cfg.Validate() {
+		slog.Warn("Invalid configuration", "path", configFilePath, "error", err.Error())
+	}
+}
+
+// MergeConfig returns base with overlay applied on top: a non-empty default_backend,
+// list_concurrency, normalize_alias_case or backend_priority in overlay replaces base's, and
+// storage_backends are merged by name with overlay's entry winning. Settings present only in
+// base survive. Either argument may be nil; neither is modified.
+func MergeConfig(base, overlay *model.Config) *model.Config {
+	merged := &model.Config{}
+	if base != nil {
+		*merged = *base
+	}
+	if overlay == nil {
+		return merged
+	}
+
+	backends := make(map[string]*model.StorageConfig, len(merged.StorageBackends)+len(overlay.StorageBackends))
+	for name, backendCfg := range merged.StorageBackends {
+		backends[name] = backendCfg
+	}
+	for name, backendCfg := range overlay.StorageBackends {
+		backends[name] = backendCfg
+	}
+	merged.StorageBackends = backends
+
+	if overlay.DefaultBackend != "" {
+		merged.DefaultBackend = overlay.DefaultBackend
+	}
+	if overlay.ListConcurrency != 0 {
+		merged.ListConcurrency = overlay.ListConcurrency
+	}
+	if overlay.NormalizeAliasCase != "" {
+		merged.NormalizeAliasCase = overlay.NormalizeAliasCase
+	}
+	if len(overlay.BackendPriority) > 0 {
+		merged.BackendPriority = overlay.BackendPriority
+	}
+	return merged
+}
+
+// absolutizeBackendPaths rewrites relative localfs paths and inmem seed directories in cfg
+// to absolute paths under configDir.
+func absolutizeBackendPaths(cfg *model.Config, configDir string) {
+	absDir, err := filepath.Abs(configDir)
+	if err != nil {
+		return
+	}
+	for _, backendCfg := range cfg.StorageBackends {
+		if backendCfg == nil {
+			continue
+		}
+		if backendCfg.LocalFS != nil && backendCfg.LocalFS.Path != "" && !filepath.IsAbs(backendCfg.LocalFS.Path) {
+			backendCfg.LocalFS.Path = filepath.Join(absDir, backendCfg.LocalFS.Path)
+		}
+		if backendCfg.InMem != nil && backendCfg.InMem.SeedDir != "" && !filepath.IsAbs(backendCfg.InMem.SeedDir) {
+			backendCfg.InMem.SeedDir = filepath.Join(absDir, backendCfg.InMem.SeedDir)
+		}
+	}
+}
+
+// PrimaryConfigPath returns the file that files next to the config (tag ontology, templates)
+// and config edits refer to: configPath itself, or the last entry of a config path list.
+func PrimaryConfigPath(configPath string) string {
+	paths := filepath.SplitList(configPath)
+	for i := len(paths) - 1; i >= 0; i-- {
+		if paths[i] == "" {
+			continue
+		}
+		if info, err := os.Stat(paths[i]); err == nil && info.IsDir() {
+			return filepath.Join(paths[i], "config.yml")
+		}
+		return paths[i]
+	}
+	return configPath
+}
+
 // LoadConfigFromString parses configuration data from a string (useful for testing).
 func (s *ConfigService) LoadConfigFromString(data string) (*model.Config, error) {
 	cfg, err := util.LoadConfigYAML([]byte(data))
@@ -154,6 +339,73 @@ func (s *ConfigService) SaveConfig(cfg *model.Config, path string) error {
 	return nil
 }
 
+// SetBackendPath sets storage_backends.<name>.localfs.path in the config file at configPath and
+// saves it. The path is stored as given; relative paths are resolved against the config file's
+// directory when the backend is used.
+func (s *ConfigService) SetBackendPath(configPath string, name string, path string) error {
+	if path == "" {
+		return fmt.Errorf("backend path cannot be empty")
+	}
+	cfg, err := s.LoadFromPath(configPath, true)
+	if err != nil {
+		return err
+	}
+	backendCfg, ok := cfg.StorageBackends[name]
+	if !ok || backendCfg == nil {
+		return fmt.Errorf("backend '%s' is not configured in %s", name, configPath)
+	}
+	if backendCfg.Type != "localfs" {
+		return fmt.Errorf("backend '%s' is of type '%s'; only localfs backends have a path", name, backendCfg.Type)
+	}
+	if backendCfg.LocalFS == nil {
+		backendCfg.LocalFS = &model.LocalFSConfig{}
+	}
+	backendCfg.LocalFS.Path = path
+	return s.SaveConfig(cfg, configPath)
+}
+
+// AddBackend adds a backend named name of type backendType to the config file at configPath and
+// saves it, leaving the other backends unchanged. path is the directory of a localfs backend
+// (required) or the seed directory of an inmem backend (optional); it is stored as given. An
+// existing backend with the same name is replaced only if force is true. default_backend is
+// set to name if setDefault is true and otherwise left as it is.
+func (s *ConfigService) AddBackend(configPath string, name string, backendType string, path string, force bool, setDefault bool) error {
+	if name == "" {
+		return fmt.Errorf("backend name cannot be empty")
+	}
+	if !model.IsSupportedBackendType(backendType) {
+		return fmt.Errorf("unsupported backend type '%s' (supported: %s)", backendType, strings.Join(model.SupportedBackendTypes, ", "))
+	}
+	if backendType == model.BackendTypeLocalFS && path == "" {
+		return fmt.Errorf("a path is required for localfs backend '%s'", name)
+	}
+	cfg, err := s.loadConfigFile(configPath)
+	if err != nil {
+		return err
+	}
+	if _, exists := cfg.StorageBackends[name]; exists && !force {
+		return fmt.Errorf("backend '%s' is already configured in %s; use --force to replace it", name, configPath)
+	}
+
+	storageConfig := &model.StorageConfig{Type: backendType}
+	switch backendType {
+	case model.BackendTypeLocalFS:
+		storageConfig.LocalFS = &model.LocalFSConfig{Path: path}
+	case model.BackendTypeInMem:
+		if path != "" {
+			storageConfig.InMem = &model.InMemConfig{SeedDir: path}
+		}
+	}
+	if cfg.StorageBackends == nil {
+		cfg.StorageBackends = make(map[string]*model.StorageConfig)
+	}
+	cfg.StorageBackends[name] = storageConfig
+	if setDefault {
+		cfg.DefaultBackend = name
+	}
+	return s.SaveConfig(cfg, configPath)
+}
+
 // GetActiveStorageBackend returns the StorageConfig for the DefaultBackend.
 func (s *ConfigService) GetActiveStorageBackend(cfg *model.Config) (*model.StorageConfig, error) {
 	if cfg == nil {
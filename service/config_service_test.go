@@ -4,6 +4,8 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+
+	"gydnc/model"
 )
 
 func TestConfigService_InitConfig(t *testing.T) {
@@ -44,6 +46,34 @@ func TestConfigService_InitConfig(t *testing.T) {
 			forceCreate: true,
 			wantErr:     false,
 		},
+		{
+			name:        "fail if nested in an existing store",
+			targetDir:   filepath.Join(tmpDir, "nested", "deeper"),
+			backendType: "localfs",
+			forceCreate: false,
+			wantErr:     true,
+		},
+		{
+			name:        "force create nested store",
+			targetDir:   filepath.Join(tmpDir, "nested", "deeper"),
+			backendType: "localfs",
+			forceCreate: true,
+			wantErr:     false,
+		},
+		{
+			name:        "create inmem store",
+			targetDir:   t.TempDir(),
+			backendType: "inmem",
+			forceCreate: false,
+			wantErr:     false,
+		},
+		{
+			name:        "fail on unsupported backend type",
+			targetDir:   t.TempDir(),
+			backendType: "s3",
+			forceCreate: false,
+			wantErr:     true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -86,6 +116,10 @@ func TestConfigService_GetEffectiveConfigPath(t *testing.T) {
 	originalEnv := os.Getenv("GYDNC_CONFIG")
 	defer os.Setenv("GYDNC_CONFIG", originalEnv)
 
+	// Run from a directory without a store above it, so discovery finds nothing
+	t.Chdir(tmpDir)
+	t.Setenv("HOME", tmpDir)
+
 	tests := []struct {
 		name          string
 		cliConfigPath string
@@ -138,3 +172,319 @@ func TestConfigService_GetEffectiveConfigPath(t *testing.T) {
 		})
 	}
 }
+
+func TestDiscoverConfigPath(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	project := filepath.Join(home, "project")
+	nested := filepath.Join(project, "docs", "guides")
+	if err := os.MkdirAll(filepath.Join(project, ".gydnc"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(nested, 0755); err != nil {
+		t.Fatal(err)
+	}
+	projectConfig := filepath.Join(project, ".gydnc", "config.yml")
+	if err := os.WriteFile(projectConfig, []byte("# Test config"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, found := DiscoverConfigPath(nested); !found || got != projectConfig {
+		t.Errorf("DiscoverConfigPath(%q) = %q, %v; want %q, true", nested, got, found, projectConfig)
+	}
+	if got, found := DiscoverConfigPath(project); !found || got != projectConfig {
+		t.Errorf("DiscoverConfigPath(%q) = %q, %v; want %q, true", project, got, found, projectConfig)
+	}
+	if got, found := DiscoverConfigPath(home); found {
+		t.Errorf("DiscoverConfigPath(%q) = %q, want no config", home, got)
+	}
+
+	// Explicit paths take precedence over discovery
+	t.Chdir(nested)
+	t.Setenv("GYDNC_CONFIG", "")
+	service := NewConfigService(NewAppContext(nil, nil))
+	if got, err := service.GetEffectiveConfigPath(""); err != nil || got != projectConfig {
+		t.Errorf("GetEffectiveConfigPath(\"\") = %q, %v; want %q", got, err, projectConfig)
+	}
+	explicit := filepath.Join(home, "explicit.yml")
+	if got, _ := service.GetEffectiveConfigPath(explicit); got != explicit {
+		t.Errorf("GetEffectiveConfigPath(%q) = %q, want the explicit path", explicit, got)
+	}
+	t.Setenv("GYDNC_CONFIG", explicit)
+	if got, _ := service.GetEffectiveConfigPath(""); got != explicit {
+		t.Errorf("GetEffectiveConfigPath(\"\") with GYDNC_CONFIG = %q, want %q", got, explicit)
+	}
+}
+
+func TestConfigService_SetBackendPath(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yml")
+	initial := `default_backend: local
+storage_backends:
+  local:
+    type: localfs
+    localfs:
+      path: old
+  mem:
+    type: inmem
+`
+	if err := os.WriteFile(configPath, []byte(initial), 0600); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	service := NewConfigService(NewAppContext(nil, nil))
+
+	if err := service.SetBackendPath(configPath, "local", "../moved"); err != nil {
+		t.Fatalf("SetBackendPath() error = %v", err)
+	}
+	cfg, err := service.LoadFromPath(configPath, true)
+	if err != nil {
+		t.Fatalf("LoadFromPath() error = %v", err)
+	}
+	if got := cfg.StorageBackends["local"].LocalFS.Path; got != "../moved" {
+		t.Errorf("path after SetBackendPath() = %q, want %q", got, "../moved")
+	}
+	if cfg.DefaultBackend != "local" || cfg.StorageBackends["mem"] == nil {
+		t.Errorf("SetBackendPath() changed other settings: %+v", cfg)
+	}
+
+	for _, name := range []string{"mem", "missing"} {
+		if err := service.SetBackendPath(configPath, name, "x"); err == nil {
+			t.Errorf("SetBackendPath(%q) succeeded, want an error", name)
+		}
+	}
+}
+
+func TestConfigService_AddBackend(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yml")
+	initial := `default_backend: local
+storage_backends:
+  local:
+    type: localfs
+    localfs:
+      path: local
+`
+	if err := os.WriteFile(configPath, []byte(initial), 0600); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	service := NewConfigService(NewAppContext(nil, nil))
+
+	if err := service.AddBackend(configPath, "staging", "localfs", "/srv/staging", false, false); err != nil {
+		t.Fatalf("AddBackend() error = %v", err)
+	}
+	if err := service.AddBackend(configPath, "seeded", "inmem", "", false, false); err != nil {
+		t.Fatalf("AddBackend(inmem) error = %v", err)
+	}
+	cfg, err := service.LoadFromPath(configPath, true)
+	if err != nil {
+		t.Fatalf("LoadFromPath() error = %v", err)
+	}
+	if cfg.DefaultBackend != "local" || cfg.StorageBackends["local"].LocalFS.Path != "local" {
+		t.Errorf("AddBackend() changed existing settings: %+v", cfg)
+	}
+	if got := cfg.StorageBackends["staging"]; got == nil || got.Type != "localfs" || got.LocalFS.Path != "/srv/staging" {
+		t.Errorf("staging backend = %+v, want localfs at /srv/staging", got)
+	}
+	if got := cfg.StorageBackends["seeded"]; got == nil || got.Type != "inmem" {
+		t.Errorf("seeded backend = %+v, want inmem", got)
+	}
+
+	errorCases := []struct {
+		name, backendName, backendType, path string
+	}{
+		{"existing name", "staging", "localfs", "/srv/other"},
+		{"localfs without path", "nopath", "localfs", ""},
+		{"unsupported type", "remote", "s3", "x"},
+		{"empty name", "", "localfs", "x"},
+	}
+	for _, tc := range errorCases {
+		if err := service.AddBackend(configPath, tc.backendName, tc.backendType, tc.path, false, false); err == nil {
+			t.Errorf("AddBackend() with %s succeeded, want an error", tc.name)
+		}
+	}
+
+	if err := service.AddBackend(configPath, "staging", "localfs", "/srv/other", true, false); err != nil {
+		t.Fatalf("AddBackend() with force error = %v", err)
+	}
+	cfg, _ = service.LoadFromPath(configPath, true)
+	if got := cfg.StorageBackends["staging"].LocalFS.Path; got != "/srv/other" {
+		t.Errorf("path after forced AddBackend() = %q, want %q", got, "/srv/other")
+	}
+	if cfg.DefaultBackend != "local" {
+		t.Errorf("default backend after AddBackend() = %q, want it unchanged", cfg.DefaultBackend)
+	}
+
+	if err := service.AddBackend(configPath, "prod", "localfs", "/srv/prod", false, true); err != nil {
+		t.Fatalf("AddBackend() with setDefault error = %v", err)
+	}
+	cfg, _ = service.LoadFromPath(configPath, true)
+	if cfg.DefaultBackend != "prod" {
+		t.Errorf("default backend after AddBackend() with setDefault = %q, want %q", cfg.DefaultBackend, "prod")
+	}
+}
+
+func TestMergeConfig(t *testing.T) {
+	base := &model.Config{
+		DefaultBackend:  "shared",
+		ListConcurrency: 4,
+		StorageBackends: map[string]*model.StorageConfig{
+			"shared": {Type: "localfs", LocalFS: &model.LocalFSConfig{Path: "/base/shared"}},
+			"common": {Type: "localfs", LocalFS: &model.LocalFSConfig{Path: "/base/common"}},
+		},
+	}
+	overlay := &model.Config{
+		DefaultBackend: "project",
+		StorageBackends: map[string]*model.StorageConfig{
+			"project": {Type: "localfs", LocalFS: &model.LocalFSConfig{Path: "/overlay/project"}},
+			"common":  {Type: "localfs", LocalFS: &model.LocalFSConfig{Path: "/overlay/common"}},
+		},
+	}
+
+	merged := MergeConfig(base, overlay)
+	if merged.DefaultBackend != "project" {
+		t.Errorf("DefaultBackend = %q, want overlay's %q", merged.DefaultBackend, "project")
+	}
+	if merged.ListConcurrency != 4 {
+		t.Errorf("ListConcurrency = %d, want base's 4", merged.ListConcurrency)
+	}
+	wantPaths := map[string]string{"shared": "/base/shared", "common": "/overlay/common", "project": "/overlay/project"}
+	if len(merged.StorageBackends) != len(wantPaths) {
+		t.Fatalf("StorageBackends has %d entries, want %d", len(merged.StorageBackends), len(wantPaths))
+	}
+	for name, wantPath := range wantPaths {
+		if got := merged.StorageBackends[name].LocalFS.Path; got != wantPath {
+			t.Errorf("backend %q path = %q, want %q", name, got, wantPath)
+		}
+	}
+	if len(base.StorageBackends) != 2 || base.DefaultBackend != "shared" {
+		t.Error("MergeConfig modified base")
+	}
+
+	t.Run("nil maps and configs", func(t *testing.T) {
+		if got := MergeConfig(nil, overlay); got.DefaultBackend != "project" || len(got.StorageBackends) != 2 {
+			t.Errorf("MergeConfig(nil, overlay) = %+v", got)
+		}
+		if got := MergeConfig(base, nil); got.DefaultBackend != "shared" || len(got.StorageBackends) != 2 {
+			t.Errorf("MergeConfig(base, nil) = %+v", got)
+		}
+		got := MergeConfig(&model.Config{DefaultBackend: "a"}, &model.Config{})
+		if got.DefaultBackend != "a" || got.StorageBackends == nil {
+			t.Errorf("MergeConfig with nil maps = %+v", got)
+		}
+	})
+}
+
+func TestConfigService_LoadFromPathList(t *testing.T) {
+	baseDir := t.TempDir()
+	projectDir := t.TempDir()
+	basePath := filepath.Join(baseDir, "config.yml")
+	overlayPath := filepath.Join(projectDir, "config.yml")
+	if err := os.WriteFile(basePath, []byte("default_backend: shared\nstorage_backends:\n  shared:\n    type: localfs\n    localfs:\n      path: shared_data\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(overlayPath, []byte("default_backend: project\nstorage_backends:\n  project:\n    type: localfs\n    localfs:\n      path: project_data\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	service := NewConfigService(NewAppContext(nil, nil))
+
+	single, err := service.LoadFromPath(basePath, true)
+	if err != nil {
+		t.Fatalf("LoadFromPath(single) error = %v", err)
+	}
+	if got := single.StorageBackends["shared"].LocalFS.Path; got != "shared_data" {
+		t.Errorf("single-file path = %q, want it left relative", got)
+	}
+
+	merged, err := service.LoadFromPath(basePath+string(os.PathListSeparator)+projectDir, true)
+	if err != nil {
+		t.Fatalf("LoadFromPath(list) error = %v", err)
+	}
+	if merged.DefaultBackend != "project" {
+		t.Errorf("DefaultBackend = %q, want %q", merged.DefaultBackend, "project")
+	}
+	if got, want := merged.StorageBackends["shared"].LocalFS.Path, filepath.Join(baseDir, "shared_data"); got != want {
+		t.Errorf("shared path = %q, want %q", got, want)
+	}
+	if got, want := merged.StorageBackends["project"].LocalFS.Path, filepath.Join(projectDir, "project_data"); got != want {
+		t.Errorf("project path = %q, want %q", got, want)
+	}
+	if got := PrimaryConfigPath(basePath + string(os.PathListSeparator) + projectDir); got != overlayPath {
+		t.Errorf("PrimaryConfigPath() = %q, want %q", got, overlayPath)
+	}
+
+	if _, err := service.LoadFromPath(basePath+string(os.PathListSeparator)+filepath.Join(projectDir, "missing.yml"), true); err == nil {
+		t.Error("LoadFromPath() with a missing file in the list succeeded, want error")
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		wantErr []string
+	}{
+		{
+			name:   "valid config",
+			config: "default_backend: main\nstorage_backends:\n  main:\n    type: localfs\n    localfs:\n      path: ./main\n  seeded:\n    type: inmem\n",
+		},
+		{
+			name:   "no default backend is allowed",
+			config: "storage_backends:\n  main:\n    type: localfs\n    localfs:\n      path: ./main\n",
+		},
+		{
+			name:    "undefined default backend",
+			config:  "default_backend: missing\nstorage_backends:\n  main:\n    type: localfs\n    localfs:\n      path: ./main\n",
+			wantErr: []string{"default_backend 'missing' is not defined in storage_backends"},
+		},
+		{
+			name:    "invalid lock timeout",
+			config:  "storage_backends:\n  main:\n    type: localfs\n    localfs:\n      path: ./main\n      lock_timeout: soon\n",
+			wantErr: []string{"localfs backend 'main' has invalid lock_timeout 'soon'; use a duration such as 10s"},
+		},
+		{
+			name:   "bad backends",
+			config: "default_backend: main\nstorage_backends:\n  main:\n    type: localfs\n    localfs:\n      path: \"\"\n  remote:\n    type: s3\n  untyped:\n    localfs:\n      path: ./x\n  \"\":\n    type: inmem\n",
+			wantErr: []string{
+				"storage_backends contains a backend with an empty name",
+				"localfs backend 'main' has an empty path; set storage_backends.main.localfs.path",
+				"backend 'remote' has unsupported type 's3' (supported: localfs, inmem)",
+				"backend 'untyped' has no type (supported: localfs, inmem)",
+			},
+		},
+	}
+
+	service := NewConfigService(NewAppContext(nil, nil))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := service.LoadConfigFromString(tt.config)
+			if err != nil {
+				t.Fatalf("LoadConfigFromString() error = %v", err)
+			}
+			errs := cfg.Validate()
+			if len(errs) != len(tt.wantErr) {
+				t.Fatalf("Validate() = %v, want %d errors", errs, len(tt.wantErr))
+			}
+			for i, err := range errs {
+				if err.Error() != tt.wantErr[i] {
+					t.Errorf("Validate()[%d] = %q, want %q", i, err.Error(), tt.wantErr[i])
+				}
+			}
+		})
+	}
+
+	for _, backendType := range model.SupportedBackendTypes {
+		t.Run("init config passes validation/"+backendType, func(t *testing.T) {
+			gydncPath, err := service.InitConfig(t.TempDir(), backendType, false)
+			if err != nil {
+				t.Fatalf("InitConfig() error = %v", err)
+			}
+			cfg, err := service.LoadFromPath(filepath.Join(gydncPath, "config.yml"), true)
+			if err != nil {
+				t.Fatalf("LoadFromPath() error = %v", err)
+			}
+			if errs := cfg.Validate(); len(errs) != 0 {
+				t.Errorf("Validate() on init config = %v, want none", errs)
+			}
+		})
+	}
+}
@@ -4,10 +4,16 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
+	"runtime"
+	"slices"
 	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"gydnc/core/content"
 	"gydnc/filter"
+	"gydnc/internal/utils"
 	"gydnc/model"
 	"gydnc/storage"
 )
@@ -26,89 +32,165 @@ func NewEntityService(ctx *AppContext) *EntityService {
 
 // ListEntities returns a list of entities from all configured backends that match the given prefix.
 // Entities are organized by backend, and backend errors are returned separately.
+// Backends are listed, and their entities stat'ed, concurrently with a bounded worker pool
+// (Config.ListConcurrency, default runtime.NumCPU()); each backend's entities are sorted by alias.
 func (s *EntityService) ListEntities(prefix string) (map[string][]model.Entity, map[string]error) {
 	backends, backendErrors := s.ctx.GetAllBackends()
 	results := make(map[string][]model.Entity)
+	concurrency := s.listConcurrency()
 
+	var mu sync.Mutex // Guards results and backendErrors
+	sem := make(chan struct{}, concurrency)
+
+	// List all backends concurrently
+	aliasesByBackend := make(map[string][]string)
+	var wg sync.WaitGroup
 	for name, backend := range backends {
-		s.ctx.Logger.Debug("Listing entities from backend", "backend", name, "prefix", prefix)
+		wg.Add(1)
+		go func(name string, backend storage.ReadOnlyBackend) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			s.ctx.Logger.Debug("Listing entities from backend", "backend", name, "prefix", prefix)
+
+			// Get the list of entity aliases from the backend
+			aliases, err := backend.List(prefix)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				backendErrors[name] = fmt.Errorf("failed to list entities from backend %s: %w", name, err)
+				return
+			}
+			aliasesByBackend[name] = aliases
+		}(name, backend)
+	}
+	wg.Wait()
 
-		// Get the list of entity aliases from the backend
-		aliases, err := backend.List(prefix)
-		if err != nil {
-			backendErrors[name] = fmt.Errorf("failed to list entities from backend %s: %w", name, err)
-			continue
+	// Stat every alias with a bounded worker pool; each job writes only its own slot
+	type statJob struct {
+		backendName string
+		index       int
+	}
+	entitiesByBackend := make(map[string][]*model.Entity, len(aliasesByBackend))
+	jobs := make(chan statJob)
+	for name, aliases := range aliasesByBackend {
+		entitiesByBackend[name] = make([]*model.Entity, len(aliases))
+	}
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				backend := backends[job.backendName]
+				alias := aliasesByBackend[job.backendName][job.index]
+
+				// Get metadata for the entity
+				metadata, err := backend.Stat(alias)
+				if err != nil && err != fs.ErrNotExist {
+					// Log the error but continue with other entities
+					s.ctx.Logger.Warn("Failed to get metadata for entity", "backend", job.backendName, "alias", alias, "error", err)
+					continue
+				}
+				entity := entityFromMetadata(alias, backend.GetName(), metadata)
+				entitiesByBackend[job.backendName][job.index] = &entity
+			}
+		}()
+	}
+	for name, aliases := range aliasesByBackend {
+		for i := range aliases {
+			jobs <- statJob{backendName: name, index: i}
 		}
+	}
+	close(jobs)
+	wg.Wait()
 
-		// Create a model.Entity for each alias
+	for name, slots := range entitiesByBackend {
 		var entities []model.Entity
-		for _, alias := range aliases {
-			// Get metadata for the entity
-			metadata, err := backend.Stat(alias)
-			if err != nil && err != fs.ErrNotExist {
-				// Log the error but continue with other entities
-				s.ctx.Logger.Warn("Failed to get metadata for entity", "backend", name, "alias", alias, "error", err)
-				continue
+		for _, entity := range slots {
+			if entity != nil {
+				entities = append(entities, *entity)
 			}
+		}
+		sort.Slice(entities, func(i, j int) bool { return entities[i].Alias < entities[j].Alias })
+		results[name] = entities
+	}
 
-			// Create an Entity with the available information
-			entity := model.Entity{
-				Alias:         alias,
-				SourceBackend: backend.GetName(),
-			}
+	return results, backendErrors
+}
 
-			// Extract common metadata fields if available
-			if metadata != nil {
-				if title, ok := metadata["title"].(string); ok {
-					entity.Title = title
-				}
-				if desc, ok := metadata["description"].(string); ok {
-					entity.Description = desc
-				}
-				if tags, ok := metadata["tags"].([]string); ok {
-					entity.Tags = tags
-					sort.Strings(entity.Tags) // Ensure tags are sorted
-				}
-				// Additional metadata goes into CustomMetadata
-				entity.CustomMetadata = make(map[string]interface{})
-				for k, v := range metadata {
-					switch k {
-					case "title", "description", "tags":
-						// Skip fields already handled
-					default:
-						entity.CustomMetadata[k] = v
-					}
-				}
-			}
+// listConcurrency returns the configured worker pool size for listing, defaulting to runtime.NumCPU().
+func (s *EntityService) listConcurrency() int {
+	if s.ctx.Config != nil && s.ctx.Config.ListConcurrency > 0 {
+		return s.ctx.Config.ListConcurrency
+	}
+	return runtime.NumCPU()
+}
 
-			// Add CID if available
-			if cid, ok := metadata["cid"].(string); ok {
-				entity.CID = cid
-			}
+// entityFromMetadata creates an Entity from the metadata a backend's Stat returned.
+func entityFromMetadata(alias string, backendName string, metadata map[string]interface{}) model.Entity {
+	// Create an Entity with the available information
+	entity := model.Entity{
+		Alias:         alias,
+		SourceBackend: backendName,
+	}
 
-			// Add pCID if available
-			if pcid, ok := metadata["pcid"].(string); ok {
-				entity.PCID = pcid
+	// Extract common metadata fields if available
+	if metadata != nil {
+		if title, ok := metadata["title"].(string); ok {
+			entity.Title = title
+		}
+		if desc, ok := metadata["description"].(string); ok {
+			entity.Description = desc
+		}
+		if tags, ok := metadata["tags"].([]string); ok {
+			entity.Tags = tags
+			sort.Strings(entity.Tags) // Ensure tags are sorted
+		}
+		// Additional metadata goes into CustomMetadata
+		entity.CustomMetadata = make(map[string]interface{})
+		for k, v := range metadata {
+			switch k {
+			case "title", "description", "tags", "cid", "pcid":
+				// Skip fields already handled
+			case "size", "mod_time", "name", "path", "rel_path", "backend_name":
+				// Backend and file properties, not entity metadata; see StatEntity
+			default:
+				entity.CustomMetadata[k] = v
 			}
-
-			entities = append(entities, entity)
 		}
+		// Only file-backed entities carry rel_path; other backends may use "path" for non-file IDs
+		if relPath, ok := metadata["rel_path"].(string); ok {
+			entity.Path, _ = metadata["path"].(string)
+			entity.RelPath = relPath
+		}
+	}
 
-		results[name] = entities
+	// Add CID if available
+	if cid, ok := metadata["cid"].(string); ok {
+		entity.CID = cid
 	}
 
-	return results, backendErrors
+	// Add pCID if available
+	if pcid, ok := metadata["pcid"].(string); ok {
+		entity.PCID = pcid
+	}
+
+	if modTime, ok := metadata["mod_time"].(time.Time); ok {
+		entity.ModTime = modTime
+	}
+
+	return entity
 }
 
 // ListEntitiesMerged returns a list of entities from all configured backends that match the given prefix.
 // Entities from all backends are collected. If filterString is provided, only entities matching the filter will be returned.
-// It ensures alias uniqueness, prioritizing the default backend, then lexical backend order for duplicates.
+// It ensures alias uniqueness, resolving duplicates in backend priority order (see orderBackendNames).
 // The final list is sorted by Alias.
 func (s *EntityService) ListEntitiesMerged(prefix string, filterString string) ([]model.Entity, map[string]error) {
 	// Get the entities from all backends, grouped by backend name
 	backendEntitiesMap, backendErrors := s.ListEntities(prefix) // This already sorts tags within each entity
 
-	defaultBackendName := s.ctx.Config.DefaultBackend
 	entitiesByAlias := make(map[string]model.Entity)
 	// Keep track of which backends an alias was found in, for logging duplicates
 	foundInBackendsByAlias := make(map[string][]string)
@@ -116,33 +198,19 @@ func (s *EntityService) ListEntitiesMerged(prefix string, filterString string) (
 	// Collect all entities and track sources
 	// var allCollectedEntitiesForProcessing []model.Entity // Removed, was unused
 
-	// Get backend names and sort them for deterministic processing order of non-default backends
 	var backendNames []string
 	for name := range backendEntitiesMap {
 		backendNames = append(backendNames, name)
 	}
-	sort.Strings(backendNames)
-
-	// First, process the default backend if it exists and has entities
-	if defaultBackendName != "" {
-		if entities, ok := backendEntitiesMap[defaultBackendName]; ok {
-			for _, entity := range entities {
-				entitiesByAlias[entity.Alias] = entity // Default backend version takes precedence
-				foundInBackendsByAlias[entity.Alias] = append(foundInBackendsByAlias[entity.Alias], entity.SourceBackend)
-			}
-		}
-	}
 
-	// Process other backends, sorted by name for deterministic conflict resolution among non-defaults
-	for _, backendName := range backendNames {
-		if backendName == defaultBackendName {
-			continue // Already processed or doesn't exist
-		}
+	// Process backends in priority order (backend_priority, then default, then by name) for
+	// deterministic conflict resolution
+	for _, backendName := range s.orderBackendNames(backendNames) {
 		if entitiesFromBackend, ok := backendEntitiesMap[backendName]; ok {
 			for _, entity := range entitiesFromBackend {
 				foundInBackendsByAlias[entity.Alias] = append(foundInBackendsByAlias[entity.Alias], entity.SourceBackend)
 				if _, exists := entitiesByAlias[entity.Alias]; !exists {
-					// If not already taken by default or a previous lexically smaller non-default backend
+					// If not already taken by a higher-priority backend
 					entitiesByAlias[entity.Alias] = entity
 					// It exists, meaning it was from default or an earlier (lexically) backend.
 					// The current entity is a duplicate we will ignore based on prioritization.
@@ -196,6 +264,34 @@ func (s *EntityService) ListEntitiesMerged(prefix string, filterString string) (
 	return mergedAndFilteredEntities, backendErrors
 }
 
+// ListEntitiesUnion returns every copy of the entities in all configured backends that match the
+// given prefix and filter, unlike ListEntitiesMerged, which keeps one copy per alias. The list is
+// sorted by alias and then by backend name.
+func (s *EntityService) ListEntitiesUnion(prefix string, filterString string) ([]model.Entity, map[string]error) {
+	backendEntitiesMap, backendErrors := s.ListEntities(prefix)
+
+	var entities []model.Entity
+	for _, backendEntities := range backendEntitiesMap {
+		entities = append(entities, backendEntities...)
+	}
+
+	if filterString != "" {
+		var err error
+		entities, err = s.FilterEntities(entities, filterString)
+		if err != nil {
+			s.ctx.Logger.Warn("Error applying filter to entities", "filter", filterString, "error", err)
+		}
+	}
+
+	sort.Slice(entities, func(i, j int) bool {
+		if entities[i].Alias != entities[j].Alias {
+			return entities[i].Alias < entities[j].Alias
+		}
+		return entities[i].SourceBackend < entities[j].SourceBackend
+	})
+	return entities, backendErrors
+}
+
 // ListEntitiesFromBackend returns a list of entities from a specific backend that match the given prefix and filter.
 // The list is sorted by Alias.
 func (s *EntityService) ListEntitiesFromBackend(backendName string, prefix string, filterString string) ([]model.Entity, error) {
@@ -223,39 +319,7 @@ func (s *EntityService) ListEntitiesFromBackend(backendName string, prefix strin
 			continue
 		}
 
-		entity := model.Entity{
-			Alias:         alias,
-			SourceBackend: backend.GetName(),
-		}
-
-		if metadata != nil {
-			if title, ok := metadata["title"].(string); ok {
-				entity.Title = title
-			}
-			if desc, ok := metadata["description"].(string); ok {
-				entity.Description = desc
-			}
-			if tags, ok := metadata["tags"].([]string); ok {
-				entity.Tags = tags
-				sort.Strings(entity.Tags)
-			}
-			if cid, ok := metadata["cid"].(string); ok {
-				entity.CID = cid
-			}
-			if pcid, ok := metadata["pcid"].(string); ok {
-				entity.PCID = pcid
-			}
-
-			entity.CustomMetadata = make(map[string]interface{})
-			for k, v := range metadata {
-				switch k {
-				case "title", "description", "tags", "cid", "pcid":
-					// These are handled directly above or are internal, skip them for CustomMetadata
-				default:
-					entity.CustomMetadata[k] = v
-				}
-			}
-		}
+		entity := entityFromMetadata(alias, backend.GetName(), metadata)
 		entities = append(entities, entity)
 	}
 
@@ -277,6 +341,211 @@ func (s *EntityService) ListEntitiesFromBackend(backendName string, prefix strin
 	return filteredEntities, nil
 }
 
+// BackendDiff describes how the entities of one backend differ from those of another.
+// Added holds aliases only present in Backend, Removed holds aliases only present in
+// ComparedTo, and Changed holds aliases present in both whose content IDs differ.
+type BackendDiff struct {
+	Backend    string   `json:"backend"`
+	ComparedTo string   `json:"compared_to"`
+	Added      []string `json:"added"`
+	Removed    []string `json:"removed"`
+	Changed    []string `json:"changed"`
+}
+
+// CompareBackends compares the entities of backendName against those of otherBackendName.
+// Entities are matched by alias and compared by content ID. All alias lists are sorted.
+func (s *EntityService) CompareBackends(backendName string, otherBackendName string, prefix string) (*BackendDiff, error) {
+	if backendName == otherBackendName {
+		return nil, fmt.Errorf("cannot compare backend '%s' against itself", backendName)
+	}
+
+	cids, err := s.listContentIDs(backendName, prefix)
+	if err != nil {
+		return nil, err
+	}
+	otherCIDs, err := s.listContentIDs(otherBackendName, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &BackendDiff{
+		Backend:    backendName,
+		ComparedTo: otherBackendName,
+		Added:      []string{},
+		Removed:    []string{},
+		Changed:    []string{},
+	}
+	for alias, cid := range cids {
+		otherCID, ok := otherCIDs[alias]
+		if !ok {
+			diff.Added = append(diff.Added, alias)
+		} else if cid != otherCID {
+			diff.Changed = append(diff.Changed, alias)
+		}
+	}
+	for alias := range otherCIDs {
+		if _, ok := cids[alias]; !ok {
+			diff.Removed = append(diff.Removed, alias)
+		}
+	}
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+	sort.Strings(diff.Changed)
+
+	s.ctx.Logger.Debug("Compared backends", "backend", backendName, "compared_to", otherBackendName,
+		"added", len(diff.Added), "removed", len(diff.Removed), "changed", len(diff.Changed))
+	return diff, nil
+}
+
+// SyncResult reports the outcome of applying a BackendDiff to its target backend.
+type SyncResult struct {
+	Copied      []string         // Aliases newly created in the target backend
+	Overwritten []string         // Aliases whose content was replaced in the target backend
+	Deleted     []string         // Aliases removed from the target backend
+	Failed      map[string]error // Per-alias failures; the remaining aliases are still processed
+}
+
+// SyncBackends applies a diff produced by CompareBackends so that diff.ComparedTo matches diff.Backend:
+// added entities are copied, changed entities are overwritten, and, if deleteRemoved is set,
+// entities only present in the target are deleted.
+func (s *EntityService) SyncBackends(diff *BackendDiff, deleteRemoved bool) *SyncResult {
+	result := &SyncResult{Failed: make(map[string]error)}
+
+	for _, alias := range diff.Added {
+		entity, err := s.GetEntity(alias, diff.Backend)
+		if err != nil {
+			result.Failed[alias] = err
+			continue
+		}
+		if _, err := s.SaveEntity(entity, diff.ComparedTo); err != nil {
+			result.Failed[alias] = err
+			continue
+		}
+		result.Copied = append(result.Copied, alias)
+	}
+
+	for _, alias := range diff.Changed {
+		entity, err := s.GetEntity(alias, diff.Backend)
+		if err != nil {
+			result.Failed[alias] = err
+			continue
+		}
+		if _, err := s.OverwriteEntity(entity, diff.ComparedTo); err != nil {
+			result.Failed[alias] = err
+			continue
+		}
+		result.Overwritten = append(result.Overwritten, alias)
+	}
+
+	if deleteRemoved {
+		for _, alias := range diff.Removed {
+			if err := s.DeleteEntity(alias, diff.ComparedTo); err != nil {
+				result.Failed[alias] = err
+				continue
+			}
+			result.Deleted = append(result.Deleted, alias)
+		}
+	}
+
+	return result
+}
+
+// listContentIDs returns a map of alias to content ID for every entity in the named backend.
+// Entities that fail to parse fall back to a hash of their raw content so they still compare.
+// An entity that is listed but cannot be read is an error rather than being left out, since a
+// missing alias would otherwise be reported as removed (and deleted by sync --delete).
+func (s *EntityService) listContentIDs(backendName string, prefix string) (map[string]string, error) {
+	backend, err := s.ctx.GetBackend(backendName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get backend '%s': %w", backendName, err)
+	}
+
+	aliases, err := backend.List(prefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list entity aliases from backend '%s' (prefix: '%s'): %w", backendName, prefix, err)
+	}
+
+	cids := make(map[string]string, len(aliases))
+	var unreadable []string
+	var firstErr error
+	for _, alias := range aliases {
+		contentBytes, _, err := backend.Read(alias)
+		if err != nil && contentBytes == nil {
+			s.ctx.Logger.Warn("Failed to read entity for comparison.", "backend", backendName, "alias", alias, "error", err)
+			unreadable = append(unreadable, alias)
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		parsed, parseErr := content.ParseG6E(contentBytes)
+		if parseErr != nil {
+			cids[alias] = utils.Sha256(contentBytes)
+			continue
+		}
+		cid, cidErr := parsed.GetContentID()
+		if cidErr != nil {
+			cids[alias] = utils.Sha256(contentBytes)
+			continue
+		}
+		cids[alias] = cid
+	}
+	if len(unreadable) > 0 {
+		sort.Strings(unreadable)
+		return nil, fmt.Errorf("failed to read %d entities from backend '%s' for comparison (%s): %w", len(unreadable), backendName, strings.Join(unreadable, ", "), firstErr)
+	}
+	return cids, nil
+}
+
+// ValidationReport lists the problems found for a single entity.
+type ValidationReport struct {
+	Alias    string   `json:"alias"`
+	Backend  string   `json:"backend"`
+	Problems []string `json:"problems"`
+}
+
+// ValidateEntities reads every entity from every configured backend and lints its raw content
+// using content.ValidateG6E. Only entities with problems are reported, sorted by backend and alias.
+// It also returns the number of entities checked and any errors accessing backends.
+func (s *EntityService) ValidateEntities() ([]ValidationReport, int, map[string]error) {
+	backends, backendErrors := s.ctx.GetAllBackends()
+
+	var backendNames []string
+	for name := range backends {
+		backendNames = append(backendNames, name)
+	}
+	sort.Strings(backendNames)
+
+	reports := []ValidationReport{}
+	checked := 0
+	for _, name := range backendNames {
+		backend := backends[name]
+		aliases, err := backend.List("")
+		if err != nil {
+			backendErrors[name] = fmt.Errorf("failed to list entities from backend %s: %w", name, err)
+			continue
+		}
+		sort.Strings(aliases)
+
+		for _, alias := range aliases {
+			checked++
+			var problems []string
+			contentBytes, _, err := backend.Read(alias)
+			if contentBytes == nil && err != nil {
+				problems = []string{fmt.Sprintf("failed to read entity: %v", err)}
+			} else {
+				problems = content.ValidateG6E(contentBytes)
+			}
+			if len(problems) > 0 {
+				reports = append(reports, ValidationReport{Alias: alias, Backend: name, Problems: problems})
+			}
+		}
+	}
+
+	s.ctx.Logger.Debug("Validated entities", "checked", checked, "with_problems", len(reports))
+	return reports, checked, backendErrors
+}
+
 // FilterEntities applies a filter string to a list of entities.
 // It uses the filter package to perform the filtering.
 func (s *EntityService) FilterEntities(entities []model.Entity, filterString string) ([]model.Entity, error) {
@@ -305,9 +574,105 @@ func (s *EntityService) FilterEntities(entities []model.Entity, filterString str
 	return filteredEntities, nil
 }
 
+// FilterEntitiesByCIDPrefix keeps the entities whose content ID (CID) starts with prefix, like an
+// abbreviated git hash. Listings don't carry CIDs, so each entity is read from its source backend to
+// compute it; the returned entities have CID set. The prefix is matched case-insensitively.
+func (s *EntityService) FilterEntitiesByCIDPrefix(entities []model.Entity, prefix string) ([]model.Entity, error) {
+	prefix = strings.ToLower(strings.TrimSpace(prefix))
+	if prefix == "" {
+		return nil, fmt.Errorf("CID prefix must not be empty")
+	}
+	if strings.Trim(prefix, "0123456789abcdef") != "" || len(prefix) > 64 {
+		return nil, fmt.Errorf("CID prefix '%s' is not a hexadecimal SHA-256 prefix", prefix)
+	}
+
+	var matched []model.Entity
+	for _, listed := range entities {
+		entity, err := s.GetEntity(listed.Alias, listed.SourceBackend)
+		if err != nil {
+			s.ctx.Logger.Warn("Skipping entity that could not be read to compute its CID", "alias", listed.Alias, "backend", listed.SourceBackend, "error", err)
+			continue
+		}
+		if strings.HasPrefix(entity.CID, prefix) {
+			listed.CID = entity.CID
+			matched = append(matched, listed)
+		}
+	}
+	return matched, nil
+}
+
+// FilterEntitiesModifiedSince keeps the entities modified at or after since. Entities whose
+// backend doesn't report modification times are kept, with one warning per such backend.
+func (s *EntityService) FilterEntitiesModifiedSince(entities []model.Entity, since time.Time) []model.Entity {
+	var kept []model.Entity
+	warned := make(map[string]bool)
+	for _, entity := range entities {
+		if entity.ModTime.IsZero() {
+			if !warned[entity.SourceBackend] {
+				s.ctx.Logger.Warn("Backend does not report modification times; including its entities", "backend", entity.SourceBackend)
+				warned[entity.SourceBackend] = true
+			}
+			kept = append(kept, entity)
+			continue
+		}
+		if !entity.ModTime.Before(since) {
+			kept = append(kept, entity)
+		}
+	}
+	return kept
+}
+
+// FindEntitiesByCID returns every entity, in any backend, whose content ID (CID) equals cid,
+// ordered by backend name and then alias. As listings don't carry CIDs, each entity is read to
+// compute it. Backends that could not be listed are reported in the returned error map.
+func (s *EntityService) FindEntitiesByCID(cid string) ([]model.Entity, map[string]error) {
+	backendEntities, backendErrors := s.ListEntities("")
+
+	backendNames := make([]string, 0, len(backendEntities))
+	for name := range backendEntities {
+		backendNames = append(backendNames, name)
+	}
+	sort.Strings(backendNames)
+
+	var matched []model.Entity
+	for _, backendName := range backendNames {
+		for _, listed := range backendEntities[backendName] {
+			entity, err := s.GetEntity(listed.Alias, backendName)
+			if err != nil {
+				s.ctx.Logger.Warn("Skipping entity that could not be read to compute its CID", "alias", listed.Alias, "backend", backendName, "error", err)
+				continue
+			}
+			if entity.CID == cid {
+				matched = append(matched, entity)
+			}
+		}
+	}
+	return matched, backendErrors
+}
+
 // GetEntity retrieves a single entity from the specified backend.
-// If backendName is empty, it searches all backends with priority given to the default backend.
+// If backendName is empty, it searches all backends in priority order (see orderBackendNames).
 func (s *EntityService) GetEntity(alias string, backendName string) (model.Entity, error) {
+	return s.getEntity(alias, backendName, nil)
+}
+
+// BackendAttempt records one backend read made while resolving an entity (see GetEntityTraced).
+type BackendAttempt struct {
+	Backend  string
+	Duration time.Duration
+	Err      error // nil if the backend returned the entity
+}
+
+// GetEntityTraced is GetEntity, also returning each backend read it made, in order, with its
+// duration; the last attempt is the one that satisfied the request if err is nil.
+func (s *EntityService) GetEntityTraced(alias string, backendName string) (model.Entity, []BackendAttempt, error) {
+	var attempts []BackendAttempt
+	entity, err := s.getEntity(alias, backendName, &attempts)
+	return entity, attempts, err
+}
+
+// getEntity implements GetEntity, appending each backend read to attempts if it is not nil.
+func (s *EntityService) getEntity(alias string, backendName string, attempts *[]BackendAttempt) (model.Entity, error) {
 	var entity model.Entity
 	var backendToUse storage.ReadOnlyBackend
 	var err error
@@ -320,13 +685,10 @@ func (s *EntityService) GetEntity(alias string, backendName string) (model.Entit
 		}
 
 		// Read the entity content and metadata
-		content, metadata, err := backendToUse.Read(alias)
+		entity, err = s.tracedReadEntity(backendToUse, alias, attempts)
 		if err != nil {
 			return entity, fmt.Errorf("failed to read entity %s from backend %s: %w", alias, backendToUse.GetName(), err)
 		}
-
-		// Create an Entity with the information
-		entity = s.createEntityFromBackendData(alias, backendToUse.GetName(), content, metadata)
 		return entity, nil
 	} else {
 		// If no backend specified, search through backends in priority order
@@ -336,47 +698,251 @@ func (s *EntityService) GetEntity(alias string, backendName string) (model.Entit
 		backends, backendErrors := s.ctx.GetAllBackends()
 		if len(backends) == 0 {
 			// No backends available
-			return entity, fmt.Errorf("no backends available: %v", backendErrors)
+			return entity, fmt.Errorf("no backends available: %s", describeBackendErrors(backendErrors, s.ctx.Config.DefaultBackend))
 		}
 
-		// Try default backend first if configured
+		// Try backends in priority order: backend_priority, then default, then by name
 		defaultBackendName := s.ctx.Config.DefaultBackend
-		if defaultBackendName != "" {
-			if defaultBackend, ok := backends[defaultBackendName]; ok {
-				content, metadata, err := defaultBackend.Read(alias)
-				if err == nil {
-					// Found in default backend
-					entity = s.createEntityFromBackendData(alias, defaultBackend.GetName(), content, metadata)
-					return entity, nil
-				}
-				// Log the error but continue with other backends
-				s.ctx.Logger.Debug("Entity not found in default backend", "backend", defaultBackendName, "alias", alias, "error", err)
-			}
+		backendNames := make([]string, 0, len(backends))
+		for name := range backends {
+			backendNames = append(backendNames, name)
 		}
 
-		// Try all other backends in the order defined in the config
-		// Note: map iteration order is not guaranteed, but we're maintaining ordering based on config
-		for name, backend := range backends {
-			// Skip default backend as we already tried it
-			if name == defaultBackendName {
-				continue
-			}
-
-			content, metadata, err := backend.Read(alias)
+		for _, name := range s.orderBackendNames(backendNames) {
+			backend := backends[name]
+			entity, err := s.tracedReadEntity(backend, alias, attempts)
 			if err == nil {
 				// Found in this backend
-				entity = s.createEntityFromBackendData(alias, backend.GetName(), content, metadata)
 				return entity, nil
 			}
 			// Log the error but continue with other backends
 			s.ctx.Logger.Debug("Entity not found in backend", "backend", name, "alias", alias, "error", err)
 		}
 
-		// Entity not found in any backend
-		return entity, fmt.Errorf("entity %s not found in any available backend", alias)
+		// Entity not found in any backend; explain any backends that failed to load, since a
+		// misconfigured backend (e.g. a bad default path) is the usual reason for a surprising miss.
+		if len(backendErrors) > 0 {
+			return entity, fmt.Errorf("entity %s not found in any available backend: %w (%s)",
+				alias, storage.ErrEntityNotFound, describeBackendErrors(backendErrors, defaultBackendName))
+		}
+		return entity, fmt.Errorf("entity %s not found in any available backend: %w", alias, storage.ErrEntityNotFound)
 	}
 }
 
+// tracedReadEntity calls readEntity, appending the read and its duration to attempts if it is not nil.
+func (s *EntityService) tracedReadEntity(backend storage.ReadOnlyBackend, alias string, attempts *[]BackendAttempt) (model.Entity, error) {
+	if attempts == nil {
+		return s.readEntity(backend, alias)
+	}
+	start := time.Now()
+	entity, err := s.readEntity(backend, alias)
+	*attempts = append(*attempts, BackendAttempt{Backend: backend.GetName(), Duration: time.Since(start), Err: err})
+	return entity, err
+}
+
+// StatEntity looks up alias with the backends' Stat method, which does not return the body, in
+// backendName or, if empty, in all backends in priority order. Besides the entity it returns the
+// raw metadata, which holds backend-specific properties such as a file's size and mod_time.
+func (s *EntityService) StatEntity(alias string, backendName string) (model.Entity, map[string]interface{}, error) {
+	var backendNames []string
+	backends := make(map[string]storage.ReadOnlyBackend)
+	var backendErrors map[string]error
+	if backendName != "" {
+		backend, err := s.ctx.GetBackend(backendName)
+		if err != nil {
+			return model.Entity{}, nil, fmt.Errorf("failed to get backend %s: %w", backendName, err)
+		}
+		backends[backendName] = backend
+		backendNames = []string{backendName}
+	} else {
+		backends, backendErrors = s.ctx.GetAllBackends()
+		if len(backends) == 0 {
+			return model.Entity{}, nil, fmt.Errorf("no backends available: %s", describeBackendErrors(backendErrors, s.ctx.Config.DefaultBackend))
+		}
+		for name := range backends {
+			backendNames = append(backendNames, name)
+		}
+		backendNames = s.orderBackendNames(backendNames)
+	}
+
+	for _, name := range backendNames {
+		metadata, err := backends[name].Stat(alias)
+		if err != nil {
+			s.ctx.Logger.Debug("Entity not found in backend", "backend", name, "alias", alias, "error", err)
+			continue
+		}
+		return entityFromMetadata(alias, name, metadata), metadata, nil
+	}
+	return model.Entity{}, nil, fmt.Errorf("entity %s not found in any available backend: %w", alias, storage.ErrEntityNotFound)
+}
+
+// orderBackendNames returns names in resolution priority order: the backends listed in the
+// config's backend_priority in that order, then the default backend, then the rest by name.
+// Names in backend_priority that are not among names are ignored.
+func (s *EntityService) orderBackendNames(names []string) []string {
+	rank := make(map[string]int, len(s.ctx.Config.BackendPriority)+1)
+	for i, name := range s.ctx.Config.BackendPriority {
+		if _, ok := rank[name]; !ok {
+			rank[name] = i
+		}
+	}
+	if defaultName := s.ctx.Config.DefaultBackend; defaultName != "" {
+		if _, ok := rank[defaultName]; !ok {
+			rank[defaultName] = len(s.ctx.Config.BackendPriority)
+		}
+	}
+
+	ordered := slices.Clone(names)
+	sort.Slice(ordered, func(i, j int) bool {
+		ri, iRanked := rank[ordered[i]]
+		rj, jRanked := rank[ordered[j]]
+		if iRanked != jRanked {
+			return iRanked
+		}
+		if iRanked && ri != rj {
+			return ri < rj
+		}
+		return ordered[i] < ordered[j]
+	})
+	return ordered
+}
+
+// GetEntityAny reads alias from all backends concurrently and returns the first copy found,
+// whichever backend answers fastest. Unlike GetEntity, the backend a copy comes from is not
+// deterministic when the alias exists in several backends.
+func (s *EntityService) GetEntityAny(alias string) (model.Entity, error) {
+	backends, backendErrors := s.ctx.GetAllBackends()
+	if len(backends) == 0 {
+		return model.Entity{}, fmt.Errorf("no backends available: %s", describeBackendErrors(backendErrors, s.ctx.Config.DefaultBackend))
+	}
+
+	type readResult struct {
+		entity model.Entity
+		err    error
+	}
+	// Buffered so that reads finishing after the first success don't block
+	results := make(chan readResult, len(backends))
+	for name, backend := range backends {
+		go func(name string, backend storage.ReadOnlyBackend) {
+			entity, err := s.readEntity(backend, alias)
+			if err != nil {
+				s.ctx.Logger.Debug("Entity not found in backend", "backend", name, "alias", alias, "error", err)
+			}
+			results <- readResult{entity: entity, err: err}
+		}(name, backend)
+	}
+
+	for range backends {
+		if result := <-results; result.err == nil {
+			return result.entity, nil
+		}
+	}
+	return model.Entity{}, fmt.Errorf("entity %s not found in any available backend: %w", alias, storage.ErrEntityNotFound)
+}
+
+// readEntity reads alias from backend. A backend that returns content together with an error
+// could read the entity but not parse it; the entity is then built leniently from the raw
+// content, and the error is recorded in entity.Warnings instead of failing the read.
+// With normalize_alias_case enabled, an alias that is not found is retried case-insensitively.
+func (s *EntityService) readEntity(backend storage.ReadOnlyBackend, alias string) (model.Entity, error) {
+	alias, contentBytes, metadata, err := s.readAlias(backend, alias)
+	if err != nil && contentBytes == nil {
+		return model.Entity{}, err
+	}
+	entity := s.createEntityFromBackendData(alias, backend.GetName(), contentBytes, metadata)
+	if err != nil {
+		s.ctx.Logger.Warn("Entity read leniently; metadata may be incomplete", "alias", alias, "backend", backend.GetName(), "error", err)
+		entity.Warnings = append(entity.Warnings, err.Error())
+	}
+	return entity, nil
+}
+
+// readAlias reads alias from backend and returns the alias as stored along with the results of
+// the backend's Read. With normalize_alias_case enabled, an alias that is not found is retried
+// case-insensitively.
+func (s *EntityService) readAlias(backend storage.ReadOnlyBackend, alias string) (string, []byte, map[string]interface{}, error) {
+	contentBytes, metadata, err := backend.Read(alias)
+	if err != nil && contentBytes == nil && s.lowercaseAliases() {
+		if stored, ok := findAliasFold(backend, alias); ok && stored != alias {
+			contentBytes, metadata, err = backend.Read(stored)
+			return stored, contentBytes, metadata, err
+		}
+	}
+	return alias, contentBytes, metadata, err
+}
+
+// lowercaseAliases reports whether the config asks for aliases to be lowercased on write.
+func (s *EntityService) lowercaseAliases() bool {
+	return s.ctx.Config != nil && s.ctx.Config.NormalizeAliasCase == model.AliasCaseLower
+}
+
+// findAliasFold returns the alias stored in backend that equals alias ignoring case,
+// preferring an exact match. It reports false if there is none.
+func findAliasFold(backend storage.ReadOnlyBackend, alias string) (string, bool) {
+	aliases, err := backend.List("")
+	if err != nil {
+		return "", false
+	}
+	found := ""
+	for _, stored := range aliases {
+		if stored == alias {
+			return stored, true
+		}
+		if found == "" && strings.EqualFold(stored, alias) {
+			found = stored
+		}
+	}
+	return found, found != ""
+}
+
+// writeAlias returns the alias to write a new entity under. An existing alias is kept as is,
+// so overwriting an entity never moves it. Otherwise, with normalization configured, the alias
+// is lowercased and an existing alias differing only in case is an error, since the two would
+// collide on case-insensitive filesystems. Finding such an alias lists the whole backend, so
+// without normalization it is not looked for; this keeps bulk writes (import, sync) linear.
+func (s *EntityService) writeAlias(backend storage.ReadOnlyBackend, alias string) (string, error) {
+	if _, err := backend.Stat(alias); err == nil {
+		return alias, nil
+	}
+	if !s.lowercaseAliases() {
+		return alias, nil
+	}
+	alias = strings.ToLower(alias)
+	if existing, ok := findAliasFold(backend, alias); ok && existing != alias {
+		return "", fmt.Errorf("cannot write '%s' to backend '%s': existing alias '%s': %w", alias, backend.GetName(), existing, storage.ErrAliasCaseCollision)
+	}
+	return alias, nil
+}
+
+// checkNoReadWarnings refuses to write an entity that was read leniently: its fields may be
+// incomplete, and writing it back would wrap the unparsed file content into the body.
+func checkNoReadWarnings(entity model.Entity) error {
+	if len(entity.Warnings) == 0 {
+		return nil
+	}
+	return fmt.Errorf("entity '%s' could not be parsed cleanly (%s); fix its content before writing it", entity.Alias, strings.Join(entity.Warnings, "; "))
+}
+
+// describeBackendErrors summarizes backend initialization errors in a single, sorted message,
+// calling out the default backend explicitly.
+func describeBackendErrors(backendErrors map[string]error, defaultBackendName string) string {
+	var names []string
+	for name := range backendErrors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var parts []string
+	for _, name := range names {
+		if name == defaultBackendName {
+			parts = append(parts, fmt.Sprintf("default backend '%s' failed to initialize: %v", name, backendErrors[name]))
+		} else {
+			parts = append(parts, fmt.Sprintf("backend '%s' failed to initialize: %v", name, backendErrors[name]))
+		}
+	}
+	return strings.Join(parts, "; ")
+}
+
 // createEntityFromBackendData is a helper function to create an Entity from backend data
 // This reduces duplication in the GetEntity method
 func (s *EntityService) createEntityFromBackendData(alias string, backendName string, contentBytes []byte, metadata map[string]interface{}) model.Entity {
@@ -400,6 +966,9 @@ func (s *EntityService) createEntityFromBackendData(alias string, backendName st
 		entity.Description = parsedData.Description
 		entity.Tags = parsedData.Tags
 		entity.Body = parsedData.Body // Correct: Use parsed body
+		// Non-standard frontmatter fields, so they survive a read/modify/write cycle.
+		// The parent CID is stored in frontmatter but modelled as entity.PCID.
+		entity.PCID, entity.CustomMetadata = content.SplitPCID(parsedData.CustomMetadata)
 		cidValue, err := parsedData.GetContentID()
 		if err != nil {
 			s.ctx.Logger.Warn("Failed to get ContentID from parsed data", "alias", alias, "error", err)
@@ -428,20 +997,23 @@ func (s *EntityService) createEntityFromBackendData(alias string, backendName st
 			sort.Strings(entity.Tags) // Ensure tags from metadata are also sorted
 		}
 
-		// CustomMetadata should only contain items from the backend's metadata map
+		// If G6E parsing failed, CustomMetadata falls back to items from the backend's metadata map
 		// that are not already standard G6E fields (Title, Description, Tags) or core model fields (CID, PCID, Alias, SourceBackend, Body).
-		entity.CustomMetadata = make(map[string]interface{})
-		for k, v := range metadata {
-			isStandardField := false
-			standardKeys := []string{"title", "description", "tags", "cid", "pcid", "alias", "sourceBackend", "body"}
-			for _, sk := range standardKeys {
-				if k == sk {
-					isStandardField = true
-					break
+		// Otherwise the parsed frontmatter is authoritative, since CustomMetadata is written back as frontmatter.
+		if parseErr != nil {
+			entity.CustomMetadata = make(map[string]interface{})
+			for k, v := range metadata {
+				isStandardField := false
+				standardKeys := []string{"title", "description", "tags", "cid", "pcid", "alias", "sourceBackend", "body"}
+				for _, sk := range standardKeys {
+					if k == sk {
+						isStandardField = true
+						break
+					}
+				}
+				if !isStandardField {
+					entity.CustomMetadata[k] = v
 				}
-			}
-			if !isStandardField {
-				entity.CustomMetadata[k] = v
 			}
 		}
 
@@ -518,10 +1090,16 @@ func (s *EntityService) determineWriteBackend(entityAlias string, explicitBacken
 // If the entity already exists in the target backend, storage.ErrEntityAlreadyExists is returned.
 // It returns the name of the backend used for saving, or an empty string if an error occurs.
 func (s *EntityService) SaveEntity(entity model.Entity, backendName string) (string, error) {
+	if err := checkNoReadWarnings(entity); err != nil {
+		return "", err
+	}
 	writableBackend, err := s.determineWriteBackend(entity.Alias, backendName, "", false) // For Save, sourceBackend is not used for selection, not an overwrite
 	if err != nil {
 		return "", err // Error already formatted by determineWriteBackend
 	}
+	if entity.Alias, err = s.writeAlias(writableBackend, entity.Alias); err != nil {
+		return "", err
+	}
 
 	// Check if entity already exists in this backend before attempting to write
 	_, statErr := writableBackend.Stat(entity.Alias)
@@ -538,17 +1116,12 @@ func (s *EntityService) SaveEntity(entity model.Entity, backendName string) (str
 		Description: entity.Description,
 		Tags:        entity.Tags,
 		Body:        entity.Body, // This is the textual body part, not the full G6E file string
-		// Ensure CustomMetadata from entity is also passed if GuidanceContent supports it directly
-		// or handle it separately if it needs to be in frontmatter.
-		// For now, assuming CustomMetadata in model.Entity might be for other uses or needs specific mapping.
+		// Non-standard frontmatter fields are written back after the standard ones.
+		// A new entity normally has no PCID; one is only written if the caller set it (e.g. sync).
+		CustomMetadata: content.JoinPCID(entity.CustomMetadata, entity.PCID),
 	}
-	// Add other known/structured metadata from entity.CustomMetadata to g6eContent if applicable.
-	// For example, if CID/PCID were stored in CustomMetadata and need to be in frontmatter.
-	// However, entity.CID and entity.PCID are top-level fields, so they should be handled directly.
-
-	// Handle CID and PCID (they might be part of frontmatter or separate)
-	// If they are part of frontmatter, corecontent.GuidanceContent should handle them.
-	// For now, let's assume they are separate and used in commitMsgDetails as before.
+	// The CID is always derived from the body, so it is not written to frontmatter
+	entity.CID, _ = g6eContent.GetContentID()
 
 	fileBytes, err := g6eContent.ToFileContent() // This creates the full G6E string with frontmatter
 	if err != nil {
@@ -578,6 +1151,70 @@ func (s *EntityService) SaveEntity(entity model.Entity, backendName string) (str
 	return writableBackend.GetName(), nil
 }
 
+// FindEntitiesByAlias reads each of aliases directly from every backend, so the cost depends on
+// the number of aliases rather than the size of the store. It returns one entity per copy found,
+// ordered by backend name and then by the order of aliases, along with the aliases found in no
+// backend. CustomMetadata holds the metadata returned by the backend's Read, including its path.
+// Aliases are resolved as readEntity does, and an entity that exists but cannot be parsed is
+// still returned, with its raw content as the body and the error in Warnings, so it can be deleted.
+func (s *EntityService) FindEntitiesByAlias(aliases []string) ([]model.Entity, []string) {
+	backends, backendErrors := s.ctx.GetAllBackends()
+	for name, err := range backendErrors {
+		s.ctx.Logger.Debug("Skipping backend that could not be initialized", "backend", name, "error", err)
+	}
+
+	backendNames := make([]string, 0, len(backends))
+	for name := range backends {
+		backendNames = append(backendNames, name)
+	}
+	sort.Strings(backendNames)
+
+	var found []model.Entity
+	foundAliases := make(map[string]bool)
+	for _, backendName := range backendNames {
+		backend := backends[backendName]
+		// Keyed by stored alias, since several requested aliases may resolve to the same one
+		seen := make(map[string]bool)
+		for _, alias := range aliases {
+			storedAlias, contentBytes, meta, err := s.readAlias(backend, alias)
+			if err != nil && contentBytes == nil {
+				continue
+			}
+			foundAliases[alias] = true
+			if seen[storedAlias] {
+				continue
+			}
+			seen[storedAlias] = true
+			entity := model.Entity{
+				Alias:          storedAlias,
+				SourceBackend:  backendName,
+				CustomMetadata: meta,
+			}
+			if parsed, parseErr := content.ParseG6E(contentBytes); parseErr == nil {
+				entity.Title = parsed.Title
+				entity.Description = parsed.Description
+				entity.Tags = parsed.Tags
+				entity.Body = parsed.Body
+				entity.CID, _ = parsed.GetContentID()
+			} else {
+				entity.Body = string(contentBytes)
+			}
+			if err != nil {
+				entity.Warnings = append(entity.Warnings, err.Error())
+			}
+			found = append(found, entity)
+		}
+	}
+
+	var notFound []string
+	for _, alias := range aliases {
+		if !foundAliases[alias] && !slices.Contains(notFound, alias) {
+			notFound = append(notFound, alias)
+		}
+	}
+	return found, notFound
+}
+
 // DeleteEntity deletes an entity from the specified backend.
 // If the backend is read-only, an error is returned.
 func (s *EntityService) DeleteEntity(alias string, backendName string) error {
@@ -609,6 +1246,12 @@ func (s *EntityService) DeleteEntity(alias string, backendName string) error {
 		return fmt.Errorf("backend %s does not implement the writable Backend interface", backendToUse.GetName())
 	}
 
+	if s.lowercaseAliases() {
+		if stored, ok := findAliasFold(writableBackend, alias); ok {
+			alias = stored
+		}
+	}
+
 	// Delete the entity
 	err = writableBackend.Delete(alias)
 	if err != nil {
@@ -621,19 +1264,41 @@ func (s *EntityService) DeleteEntity(alias string, backendName string) error {
 // OverwriteEntity saves an entity to the specified backend, overwriting it if it already exists.
 // If the backend is read-only, an error is returned.
 // It returns the name of the backend used for overwriting, or an empty string if an error occurs.
+// The CID of the replaced version is written to the frontmatter as the new version's pcid.
 func (s *EntityService) OverwriteEntity(entity model.Entity, backendName string) (string, error) {
+	if err := checkNoReadWarnings(entity); err != nil {
+		return "", err
+	}
 	writableBackend, err := s.determineWriteBackend(entity.Alias, backendName, entity.SourceBackend, true) // For Overwrite, pass entity.SourceBackend and isOverwrite=true
 	if err != nil {
 		return "", err // Error already formatted by determineWriteBackend
 	}
+	if entity.Alias, err = s.writeAlias(writableBackend, entity.Alias); err != nil {
+		return "", err
+	}
 
-	// Prepare G6E content from model.Entity
 	g6eContent := content.GuidanceContent{
 		Title:       entity.Title,
 		Description: entity.Description,
 		Tags:        entity.Tags,
 		Body:        entity.Body,
 	}
+	entity.CID, _ = g6eContent.GetContentID()
+
+	// Record the version being replaced as the parent, so successive overwrites form a lineage chain.
+	// If the body is unchanged (same CID), the existing parent is kept rather than pointing at itself.
+	if previousBytes, _, readErr := writableBackend.Read(entity.Alias); readErr == nil {
+		if previous, parseErr := content.ParseG6E(previousBytes); parseErr == nil {
+			previousCID, _ := previous.GetContentID()
+			previousPCID, _ := content.SplitPCID(previous.CustomMetadata)
+			if previousCID != entity.CID {
+				entity.PCID = previousCID
+			} else if entity.PCID == "" {
+				entity.PCID = previousPCID
+			}
+		}
+	}
+	g6eContent.CustomMetadata = content.JoinPCID(entity.CustomMetadata, entity.PCID)
 
 	fileBytes, err := g6eContent.ToFileContent()
 	if err != nil {
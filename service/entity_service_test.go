@@ -0,0 +1,700 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gydnc/core/content"
+	"gydnc/model"
+	"gydnc/storage"
+	"gydnc/storage/inmem"
+	"gydnc/storage/localfs"
+)
+
+// slowStatStore adds a fixed latency to Stat to emulate backends where each metadata read costs I/O.
+type slowStatStore struct {
+	*inmem.Store
+	delay time.Duration
+}
+
+func (s *slowStatStore) Stat(id string) (map[string]interface{}, error) {
+	time.Sleep(s.delay)
+	return s.Store.Stat(id)
+}
+
+// newInmemEntityService registers inmem backends with n entities each and returns a service over them.
+func newInmemEntityService(tb testing.TB, backendNames []string, n int, statDelay time.Duration, concurrency int) *EntityService {
+	tb.Helper()
+	storage.ClearRegistry()
+	tb.Cleanup(storage.ClearRegistry)
+
+	cfg := &model.Config{
+		StorageBackends: make(map[string]*model.StorageConfig),
+		ListConcurrency: concurrency,
+	}
+	for _, name := range backendNames {
+		cfg.StorageBackends[name] = &model.StorageConfig{Type: "inmem"}
+
+		store := inmem.NewStore(name)
+		entities := make(map[string][]byte, n)
+		metadata := make(map[string]map[string]interface{}, n)
+		for i := 0; i < n; i++ {
+			alias := fmt.Sprintf("entity-%04d", i)
+			entities[alias] = []byte(fmt.Sprintf("---\ntitle: %s\n---\nbody\n", alias))
+			metadata[alias] = map[string]interface{}{"title": alias}
+		}
+		store.LoadEntities(entities, metadata)
+
+		var backend storage.ReadOnlyBackend = store
+		if statDelay > 0 {
+			backend = &slowStatStore{Store: store, delay: statDelay}
+		}
+		storage.BackendRegistry[name] = backend
+	}
+
+	return NewAppContext(cfg, nil).EntityService
+}
+
+func TestListEntities_DeterministicOrder(t *testing.T) {
+	svc := newInmemEntityService(t, []string{"a", "b"}, 200, 0, 8)
+
+	first, errs := svc.ListEntities("")
+	if len(errs) != 0 {
+		t.Fatalf("ListEntities() backend errors = %v", errs)
+	}
+	for name, entities := range first {
+		if len(entities) != 200 {
+			t.Errorf("backend %s: got %d entities, want 200", name, len(entities))
+		}
+		if !sort.SliceIsSorted(entities, func(i, j int) bool { return entities[i].Alias < entities[j].Alias }) {
+			t.Errorf("backend %s: entities are not sorted by alias", name)
+		}
+		for _, e := range entities {
+			if e.Title != e.Alias || e.SourceBackend != name {
+				t.Errorf("backend %s: unexpected entity %+v", name, e)
+				break
+			}
+		}
+	}
+
+	for i := 0; i < 5; i++ {
+		again, _ := svc.ListEntities("")
+		if !reflect.DeepEqual(again, first) {
+			t.Fatalf("ListEntities() result differs between runs")
+		}
+	}
+}
+
+func BenchmarkListEntities(b *testing.B) {
+	// Fixed sizes rather than runtime.NumCPU(): the simulated I/O sleeps, so it overlaps even on one CPU
+	for _, concurrency := range []int{1, 4, 16} {
+		b.Run(fmt.Sprintf("concurrency=%d", concurrency), func(b *testing.B) {
+			// 1000 entities with 20µs of simulated I/O per Stat
+			svc := newInmemEntityService(b, []string{"bench"}, 1000, 20*time.Microsecond, concurrency)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				svc.ListEntities("")
+			}
+		})
+	}
+}
+
+func TestOverwriteEntity_RecordsPCID(t *testing.T) {
+	storage.ClearRegistry()
+	t.Cleanup(storage.ClearRegistry)
+
+	store, err := localfs.NewStore(model.LocalFSConfig{Path: t.TempDir()}, "")
+	if err != nil {
+		t.Fatalf("NewStore() error = %v", err)
+	}
+	store.SetName("main")
+	storage.BackendRegistry["main"] = store
+	cfg := &model.Config{
+		DefaultBackend:  "main",
+		StorageBackends: map[string]*model.StorageConfig{"main": {Type: "localfs"}},
+	}
+	svc := NewAppContext(cfg, nil).EntityService
+
+	get := func() model.Entity {
+		t.Helper()
+		entity, err := svc.GetEntity("lineage", "main")
+		if err != nil {
+			t.Fatalf("GetEntity() error = %v", err)
+		}
+		return entity
+	}
+
+	if _, err := svc.SaveEntity(model.Entity{Alias: "lineage", Title: "v1", Body: "first\n"}, "main"); err != nil {
+		t.Fatalf("SaveEntity() error = %v", err)
+	}
+	v1 := get()
+	if v1.PCID != "" {
+		t.Errorf("new entity PCID = %q, want empty", v1.PCID)
+	}
+
+	v1.Body = "second\n"
+	if _, err := svc.OverwriteEntity(v1, "main"); err != nil {
+		t.Fatalf("OverwriteEntity() error = %v", err)
+	}
+	v2 := get()
+	if v2.PCID != v1.CID || v2.CID == v1.CID {
+		t.Errorf("after body change PCID = %q, CID = %q; want PCID %q and a new CID", v2.PCID, v2.CID, v1.CID)
+	}
+	if _, ok := v2.CustomMetadata[content.PCIDKey]; ok {
+		t.Errorf("pcid leaked into CustomMetadata: %v", v2.CustomMetadata)
+	}
+
+	// A metadata-only change keeps the body, so the parent must not point at the entity itself
+	v2.Title = "v2 retitled"
+	if _, err := svc.OverwriteEntity(v2, "main"); err != nil {
+		t.Fatalf("OverwriteEntity() error = %v", err)
+	}
+	if v3 := get(); v3.PCID != v1.CID || v3.Title != "v2 retitled" {
+		t.Errorf("after metadata-only change PCID = %q, title = %q; want PCID %q", v3.PCID, v3.Title, v1.CID)
+	}
+}
+
+func TestGetEntity_LenientParse(t *testing.T) {
+	storage.ClearRegistry()
+	t.Cleanup(storage.ClearRegistry)
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "broken.g6e"), []byte("---\ntitle: [unclosed\n---\nbody\n"), 0644); err != nil {
+		t.Fatalf("failed to write fixture: %v", err)
+	}
+	store, err := localfs.NewStore(model.LocalFSConfig{Path: dir}, "")
+	if err != nil {
+		t.Fatalf("NewStore() error = %v", err)
+	}
+	store.SetName("main")
+	storage.BackendRegistry["main"] = store
+	cfg := &model.Config{
+		DefaultBackend:  "main",
+		StorageBackends: map[string]*model.StorageConfig{"main": {Type: "localfs"}},
+	}
+	svc := NewAppContext(cfg, nil).EntityService
+
+	for _, backendName := range []string{"", "main"} {
+		entity, err := svc.GetEntity("broken", backendName)
+		if err != nil {
+			t.Fatalf("GetEntity(backend %q) error = %v, want a lenient read", backendName, err)
+		}
+		if len(entity.Warnings) != 1 || !strings.Contains(entity.Warnings[0], "failed to parse") {
+			t.Errorf("GetEntity(backend %q) warnings = %v", backendName, entity.Warnings)
+		}
+	}
+
+	entity, _ := svc.GetEntity("broken", "")
+	entity.Title = "Fixed"
+	if _, err := svc.OverwriteEntity(entity, "main"); err == nil {
+		t.Errorf("OverwriteEntity() of a leniently read entity succeeded, want an error")
+	}
+}
+
+func TestNormalizeAliasCase(t *testing.T) {
+	storage.ClearRegistry()
+	t.Cleanup(storage.ClearRegistry)
+
+	dir := t.TempDir()
+	store, err := localfs.NewStore(model.LocalFSConfig{Path: dir}, "")
+	if err != nil {
+		t.Fatalf("NewStore() error = %v", err)
+	}
+	store.SetName("main")
+	storage.BackendRegistry["main"] = store
+	cfg := &model.Config{
+		DefaultBackend:     "main",
+		StorageBackends:    map[string]*model.StorageConfig{"main": {Type: "localfs"}},
+		NormalizeAliasCase: model.AliasCaseLower,
+	}
+	svc := NewAppContext(cfg, nil).EntityService
+
+	if _, err := svc.SaveEntity(model.Entity{Alias: "Team/Style", Title: "Style", Body: "v1\n"}, "main"); err != nil {
+		t.Fatalf("SaveEntity() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "team", "style.g6e")); err != nil {
+		t.Errorf("expected the alias to be written lowercased: %v", err)
+	}
+
+	entity, err := svc.GetEntity("TEAM/STYLE", "")
+	if err != nil {
+		t.Fatalf("GetEntity() of a differently cased alias error = %v", err)
+	}
+	if entity.Alias != "team/style" {
+		t.Errorf("GetEntity() alias = %q, want %q", entity.Alias, "team/style")
+	}
+
+	if _, err := svc.SaveEntity(model.Entity{Alias: "TEAM/style", Title: "Again", Body: "v2\n"}, "main"); !errors.Is(err, storage.ErrEntityAlreadyExists) {
+		t.Errorf("SaveEntity() of an existing alias in another case error = %v, want ErrEntityAlreadyExists", err)
+	}
+
+	// An alias stored before normalization was enabled cannot be shadowed by its lowercase form.
+	if err := os.WriteFile(filepath.Join(dir, "Legacy.g6e"), []byte("---\ntitle: Legacy\n---\nbody\n"), 0644); err != nil {
+		t.Fatalf("failed to write fixture: %v", err)
+	}
+	if _, err := svc.SaveEntity(model.Entity{Alias: "legacy", Title: "New", Body: "v1\n"}, "main"); !errors.Is(err, storage.ErrAliasCaseCollision) {
+		t.Errorf("SaveEntity() colliding with a mixed-case alias error = %v, want ErrAliasCaseCollision", err)
+	}
+	legacy, err := svc.GetEntity("legacy", "main")
+	if err != nil {
+		t.Fatalf("GetEntity() of a mixed-case stored alias error = %v", err)
+	}
+	legacy.Title = "Updated"
+	if _, err := svc.OverwriteEntity(legacy, "main"); err != nil {
+		t.Fatalf("OverwriteEntity() of a mixed-case stored alias error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "legacy.g6e")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("OverwriteEntity() should keep the stored alias, found legacy.g6e (err = %v)", err)
+	}
+}
+
+func TestListEntitiesFromBackend_ModTime(t *testing.T) {
+	storage.ClearRegistry()
+	t.Cleanup(storage.ClearRegistry)
+
+	store, err := localfs.NewStore(model.LocalFSConfig{Path: t.TempDir()}, "")
+	if err != nil {
+		t.Fatalf("NewStore() error = %v", err)
+	}
+	store.SetName("main")
+	storage.BackendRegistry["main"] = store
+	cfg := &model.Config{
+		DefaultBackend:  "main",
+		StorageBackends: map[string]*model.StorageConfig{"main": {Type: "localfs"}},
+	}
+	svc := NewAppContext(cfg, nil).EntityService
+
+	if _, err := svc.SaveEntity(model.Entity{Alias: "note", Title: "Note", Body: "v1\n"}, "main"); err != nil {
+		t.Fatalf("SaveEntity() error = %v", err)
+	}
+	listed, err := svc.ListEntitiesFromBackend("main", "", "")
+	if err != nil || len(listed) != 1 {
+		t.Fatalf("ListEntitiesFromBackend() = %+v, %v; want the saved entity", listed, err)
+	}
+	if listed[0].ModTime.IsZero() {
+		t.Errorf("ListEntitiesFromBackend() ModTime is zero, want the file modification time")
+	}
+	if listed[0].RelPath != "note.g6e" || !filepath.IsAbs(listed[0].Path) {
+		t.Errorf("ListEntitiesFromBackend() Path = %q, RelPath = %q; want the entity's file", listed[0].Path, listed[0].RelPath)
+	}
+	for _, key := range []string{"size", "mod_time", "name", "path", "rel_path"} {
+		if _, ok := listed[0].CustomMetadata[key]; ok {
+			t.Errorf("ListEntitiesFromBackend() CustomMetadata contains %q", key)
+		}
+	}
+}
+
+// listCountingStore counts List calls, to check which operations scan a whole backend.
+type listCountingStore struct {
+	*inmem.Store
+	lists atomic.Int64
+}
+
+func (s *listCountingStore) List(prefix string) ([]string, error) {
+	s.lists.Add(1)
+	return s.Store.List(prefix)
+}
+
+func TestSaveEntity_ScansForCaseCollisionsOnlyWhenNormalizing(t *testing.T) {
+	storage.ClearRegistry()
+	t.Cleanup(storage.ClearRegistry)
+
+	store := &listCountingStore{Store: inmem.NewWritableStore("mem")}
+	storage.BackendRegistry["mem"] = store
+	cfg := &model.Config{
+		DefaultBackend:  "mem",
+		StorageBackends: map[string]*model.StorageConfig{"mem": {Type: "inmem"}},
+	}
+	svc := NewAppContext(cfg, nil).EntityService
+
+	for i := 0; i < 3; i++ {
+		if _, err := svc.SaveEntity(model.Entity{Alias: fmt.Sprintf("Note-%d", i), Body: "v1\n"}, "mem"); err != nil {
+			t.Fatalf("SaveEntity() error = %v", err)
+		}
+	}
+	if n := store.lists.Load(); n != 0 {
+		t.Errorf("SaveEntity() without normalization listed the backend %d times, want 0", n)
+	}
+
+	cfg.NormalizeAliasCase = model.AliasCaseLower
+	if _, err := svc.SaveEntity(model.Entity{Alias: "note-0", Body: "v1\n"}, "mem"); !errors.Is(err, storage.ErrAliasCaseCollision) {
+		t.Errorf("SaveEntity() colliding with a mixed-case alias error = %v, want ErrAliasCaseCollision", err)
+	}
+	if store.lists.Load() == 0 {
+		t.Errorf("SaveEntity() with normalization did not look for case collisions")
+	}
+}
+
+func TestCompareBackends_UnreadableEntity(t *testing.T) {
+	storage.ClearRegistry()
+	t.Cleanup(storage.ClearRegistry)
+
+	cfg := &model.Config{StorageBackends: make(map[string]*model.StorageConfig)}
+	dirs := make(map[string]string)
+	for _, name := range []string{"a", "b"} {
+		dirs[name] = t.TempDir()
+		store, err := localfs.NewStore(model.LocalFSConfig{Path: dirs[name]}, "")
+		if err != nil {
+			t.Fatalf("NewStore() error = %v", err)
+		}
+		store.SetName(name)
+		storage.BackendRegistry[name] = store
+		cfg.StorageBackends[name] = &model.StorageConfig{Type: "localfs"}
+	}
+	svc := NewAppContext(cfg, nil).EntityService
+	if _, err := svc.SaveEntity(model.Entity{Alias: "rule", Body: "v1\n"}, "b"); err != nil {
+		t.Fatalf("SaveEntity() error = %v", err)
+	}
+	// A truncated gzip file is listed but cannot be read
+	if err := os.WriteFile(filepath.Join(dirs["a"], "rule.g6e.gz"), []byte{0x1f, 0x8b}, 0644); err != nil {
+		t.Fatalf("failed to write fixture: %v", err)
+	}
+
+	if diff, err := svc.CompareBackends("a", "b", ""); err == nil {
+		t.Errorf("CompareBackends() = %+v, want an error for the unreadable entity", diff)
+	}
+}
+
+func TestFindEntitiesByCID(t *testing.T) {
+	svc := newInmemEntityService(t, []string{"b", "a"}, 2, 0, 0)
+
+	body := content.GuidanceContent{Body: "body\n"}
+	cid, err := body.GetContentID()
+	if err != nil {
+		t.Fatalf("GetContentID() error = %v", err)
+	}
+
+	matches, backendErrors := svc.FindEntitiesByCID(cid)
+	if len(backendErrors) > 0 {
+		t.Fatalf("FindEntitiesByCID() backend errors = %v", backendErrors)
+	}
+	var got []string
+	for _, m := range matches {
+		got = append(got, m.SourceBackend+"/"+m.Alias)
+	}
+	want := []string{"a/entity-0000", "a/entity-0001", "b/entity-0000", "b/entity-0001"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FindEntitiesByCID() = %v, want %v", got, want)
+	}
+
+	if matches, _ := svc.FindEntitiesByCID(strings.Repeat("0", 64)); len(matches) != 0 {
+		t.Errorf("FindEntitiesByCID(unknown) = %v, want none", matches)
+	}
+}
+
+func TestGetEntityAny(t *testing.T) {
+	svc := newInmemEntityService(t, []string{"a", "b"}, 1, 0, 0)
+	only := inmem.NewStore("c")
+	only.LoadEntities(map[string][]byte{"only-c": []byte("---\ntitle: c\n---\nc body\n")}, nil)
+	storage.BackendRegistry["c"] = only
+	svc.ctx.Config.StorageBackends["c"] = &model.StorageConfig{Type: "inmem"}
+
+	entity, err := svc.GetEntityAny("entity-0000")
+	if err != nil {
+		t.Fatalf("GetEntityAny() error = %v", err)
+	}
+	if entity.SourceBackend != "a" && entity.SourceBackend != "b" {
+		t.Errorf("GetEntityAny() backend = %q, want a or b", entity.SourceBackend)
+	}
+
+	if entity, err := svc.GetEntityAny("only-c"); err != nil || entity.SourceBackend != "c" {
+		t.Errorf("GetEntityAny(only-c) = backend %q, error %v; want backend c", entity.SourceBackend, err)
+	}
+
+	if _, err := svc.GetEntityAny("missing"); !errors.Is(err, storage.ErrEntityNotFound) {
+		t.Errorf("GetEntityAny(missing) error = %v, want ErrEntityNotFound", err)
+	}
+}
+
+func TestFindEntitiesByAlias(t *testing.T) {
+	svc := newInmemEntityService(t, []string{"b", "a"}, 2, 0, 0)
+
+	found, notFound := svc.FindEntitiesByAlias([]string{"entity-0001", "missing", "entity-0000", "entity-0001"})
+	var got []string
+	for _, e := range found {
+		got = append(got, e.SourceBackend+"/"+e.Alias)
+	}
+	want := []string{"a/entity-0001", "a/entity-0000", "b/entity-0001", "b/entity-0000"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FindEntitiesByAlias() found = %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(notFound, []string{"missing"}) {
+		t.Errorf("FindEntitiesByAlias() notFound = %v, want [missing]", notFound)
+	}
+	if found[0].Title != "entity-0001" || found[0].CID == "" {
+		t.Errorf("FindEntitiesByAlias() entity = %+v, want title and CID set", found[0])
+	}
+}
+
+func TestFindEntitiesByAlias_MalformedAndCaseFolded(t *testing.T) {
+	storage.ClearRegistry()
+	t.Cleanup(storage.ClearRegistry)
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "broken.g6e"), []byte("---\ntitle: [unclosed\n---\nbody\n"), 0644); err != nil {
+		t.Fatalf("failed to write fixture: %v", err)
+	}
+	store, err := localfs.NewStore(model.LocalFSConfig{Path: dir}, "")
+	if err != nil {
+		t.Fatalf("NewStore() error = %v", err)
+	}
+	store.SetName("main")
+	storage.BackendRegistry["main"] = store
+	cfg := &model.Config{
+		DefaultBackend:     "main",
+		StorageBackends:    map[string]*model.StorageConfig{"main": {Type: "localfs"}},
+		NormalizeAliasCase: model.AliasCaseLower,
+	}
+	svc := NewAppContext(cfg, nil).EntityService
+	if _, err := svc.SaveEntity(model.Entity{Alias: "Team/Style", Title: "Style", Body: "v1\n"}, "main"); err != nil {
+		t.Fatalf("SaveEntity() error = %v", err)
+	}
+
+	found, notFound := svc.FindEntitiesByAlias([]string{"broken", "TEAM/STYLE", "team/style"})
+	if len(notFound) != 0 {
+		t.Errorf("FindEntitiesByAlias() notFound = %v, want none", notFound)
+	}
+	if len(found) != 2 {
+		t.Fatalf("FindEntitiesByAlias() found %d entities, want 2: %+v", len(found), found)
+	}
+	if found[0].Alias != "broken" || len(found[0].Warnings) != 1 || !strings.Contains(found[0].Body, "[unclosed") {
+		t.Errorf("FindEntitiesByAlias() malformed entity = %+v, want its raw content and a warning", found[0])
+	}
+	if found[1].Alias != "team/style" || found[1].Title != "Style" {
+		t.Errorf("FindEntitiesByAlias() case-folded entity = %+v, want the stored alias team/style", found[1])
+	}
+}
+
+func TestListEntitiesUnion(t *testing.T) {
+	svc := newInmemEntityService(t, []string{"b", "a"}, 2, 0, 0)
+
+	entities, backendErrors := svc.ListEntitiesUnion("", "")
+	if len(backendErrors) != 0 {
+		t.Fatalf("ListEntitiesUnion() backendErrors = %v", backendErrors)
+	}
+	var got []string
+	for _, e := range entities {
+		got = append(got, e.SourceBackend+"/"+e.Alias)
+	}
+	want := []string{"a/entity-0000", "b/entity-0000", "a/entity-0001", "b/entity-0001"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListEntitiesUnion() = %v, want %v", got, want)
+	}
+
+	merged, _ := svc.ListEntitiesMerged("", "")
+	if len(merged) != 2 {
+		t.Errorf("ListEntitiesMerged() returned %d entities, want 2", len(merged))
+	}
+}
+
+func TestOrderBackendNames(t *testing.T) {
+	svc := newInmemEntityService(t, nil, 0, 0, 0)
+	names := []string{"d", "c", "b", "a"}
+
+	tests := []struct {
+		name            string
+		defaultBackend  string
+		backendPriority []string
+		want            []string
+	}{
+		{name: "by name", want: []string{"a", "b", "c", "d"}},
+		{name: "default first", defaultBackend: "c", want: []string{"c", "a", "b", "d"}},
+		{name: "priority then default", defaultBackend: "c", backendPriority: []string{"d", "missing", "b"}, want: []string{"d", "b", "c", "a"}},
+		{name: "default listed in priority", defaultBackend: "b", backendPriority: []string{"d", "b"}, want: []string{"d", "b", "a", "c"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc.ctx.Config.DefaultBackend = tt.defaultBackend
+			svc.ctx.Config.BackendPriority = tt.backendPriority
+			if got := svc.orderBackendNames(names); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("orderBackendNames() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBackendPriorityResolution(t *testing.T) {
+	svc := newInmemEntityService(t, []string{"a", "b", "c"}, 1, 0, 0)
+	svc.ctx.Config.DefaultBackend = "a"
+	svc.ctx.Config.BackendPriority = []string{"c"}
+
+	entity, err := svc.GetEntity("entity-0000", "")
+	if err != nil {
+		t.Fatalf("GetEntity() error = %v", err)
+	}
+	if entity.SourceBackend != "c" {
+		t.Errorf("GetEntity() backend = %s, want c", entity.SourceBackend)
+	}
+
+	merged, _ := svc.ListEntitiesMerged("", "")
+	if len(merged) != 1 || merged[0].SourceBackend != "c" {
+		t.Errorf("ListEntitiesMerged() = %+v, want the copy from c", merged)
+	}
+}
+
+func TestStatEntity(t *testing.T) {
+	svc := newInmemEntityService(t, []string{"a", "b"}, 1, 0, 0)
+	svc.ctx.Config.DefaultBackend = "b"
+
+	entity, metadata, err := svc.StatEntity("entity-0000", "")
+	if err != nil {
+		t.Fatalf("StatEntity() error = %v", err)
+	}
+	if entity.SourceBackend != "b" || entity.Title != "entity-0000" || metadata["path"] != "entity-0000" {
+		t.Errorf("StatEntity() = %+v, %v; want the copy in b", entity, metadata)
+	}
+
+	entity, _, err = svc.StatEntity("entity-0000", "a")
+	if err != nil || entity.SourceBackend != "a" {
+		t.Errorf("StatEntity(backend a) = %+v, %v; want the copy in a", entity, err)
+	}
+
+	if _, _, err := svc.StatEntity("missing", ""); !errors.Is(err, storage.ErrEntityNotFound) {
+		t.Errorf("StatEntity(missing) error = %v, want ErrEntityNotFound", err)
+	}
+}
+
+func TestFilterEntitiesModifiedSince(t *testing.T) {
+	svc := newInmemEntityService(t, nil, 0, 0, 0)
+	now := time.Now()
+	entities := []model.Entity{
+		{Alias: "fresh", SourceBackend: "disk", ModTime: now.Add(-time.Hour)},
+		{Alias: "stale", SourceBackend: "disk", ModTime: now.Add(-48 * time.Hour)},
+		{Alias: "unknown", SourceBackend: "mem"},
+	}
+
+	var got []string
+	for _, e := range svc.FilterEntitiesModifiedSince(entities, now.Add(-24*time.Hour)) {
+		got = append(got, e.Alias)
+	}
+	if want := []string{"fresh", "unknown"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FilterEntitiesModifiedSince() = %v, want %v", got, want)
+	}
+}
+
+func TestGetEntityTraced(t *testing.T) {
+	svc := newInmemEntityService(t, []string{"a", "b"}, 1, 0, 0)
+	svc.ctx.Config.DefaultBackend = "b"
+
+	entity, attempts, err := svc.GetEntityTraced("entity-0000", "")
+	if err != nil || entity.SourceBackend != "b" {
+		t.Fatalf("GetEntityTraced() = %+v, %v; want the copy in b", entity, err)
+	}
+	if len(attempts) != 1 || attempts[0].Backend != "b" || attempts[0].Err != nil {
+		t.Errorf("GetEntityTraced() attempts = %+v, want one successful read from b", attempts)
+	}
+
+	_, attempts, err = svc.GetEntityTraced("missing", "")
+	if !errors.Is(err, storage.ErrEntityNotFound) {
+		t.Fatalf("GetEntityTraced(missing) error = %v, want ErrEntityNotFound", err)
+	}
+	var tried []string
+	for _, attempt := range attempts {
+		if attempt.Err == nil {
+			t.Errorf("GetEntityTraced(missing) attempt %+v succeeded", attempt)
+		}
+		tried = append(tried, attempt.Backend)
+	}
+	if want := []string{"b", "a"}; !reflect.DeepEqual(tried, want) {
+		t.Errorf("GetEntityTraced(missing) tried %v, want %v", tried, want)
+	}
+}
+
+func TestEntityService_InmemWritableStore(t *testing.T) {
+	storage.ClearRegistry()
+	t.Cleanup(storage.ClearRegistry)
+
+	var store storage.Backend = inmem.NewWritableStore("mem")
+	storage.BackendRegistry["mem"] = store
+	cfg := &model.Config{
+		DefaultBackend:  "mem",
+		StorageBackends: map[string]*model.StorageConfig{"mem": {Type: "inmem"}},
+	}
+	svc := NewAppContext(cfg, nil).EntityService
+
+	if _, err := svc.SaveEntity(model.Entity{Alias: "note", Title: "Note", Tags: []string{"scope:code"}, Body: "v1\n"}, ""); err != nil {
+		t.Fatalf("SaveEntity() error = %v", err)
+	}
+	listed, err := svc.ListEntitiesFromBackend("mem", "", "scope:code")
+	if err != nil || len(listed) != 1 || listed[0].Title != "Note" {
+		t.Fatalf("ListEntitiesFromBackend() = %+v, %v; want the saved entity", listed, err)
+	}
+
+	entity, err := svc.GetEntity("note", "mem")
+	if err != nil {
+		t.Fatalf("GetEntity() error = %v", err)
+	}
+	entity.Body = "v2\n"
+	if _, err := svc.OverwriteEntity(entity, "mem"); err != nil {
+		t.Fatalf("OverwriteEntity() error = %v", err)
+	}
+	if updated, _ := svc.GetEntity("note", "mem"); updated.Body != "v2\n" || updated.PCID != entity.CID {
+		t.Errorf("after overwrite body = %q, PCID = %q; want v2 with PCID %q", updated.Body, updated.PCID, entity.CID)
+	}
+
+	if err := svc.DeleteEntity("note", "mem"); err != nil {
+		t.Fatalf("DeleteEntity() error = %v", err)
+	}
+	if _, err := svc.GetEntity("note", "mem"); err == nil {
+		t.Errorf("GetEntity() after delete succeeded, want an error")
+	}
+
+	if inmem.NewStore("ro").Write("x", []byte("---\ntitle: x\n---\n"), nil) == nil {
+		t.Errorf("Write() on a read-only inmem store succeeded, want an error")
+	}
+}
+
+// readCountingStore counts Read calls, to check which operations need entity bodies.
+type readCountingStore struct {
+	*inmem.Store
+	reads atomic.Int64
+}
+
+func (s *readCountingStore) Read(id string) ([]byte, map[string]interface{}, error) {
+	s.reads.Add(1)
+	return s.Store.Read(id)
+}
+
+func TestListEntitiesMerged_FilterUsesStatOnly(t *testing.T) {
+	storage.ClearRegistry()
+	t.Cleanup(storage.ClearRegistry)
+
+	store := inmem.NewStore("main")
+	store.LoadEntities(
+		map[string][]byte{
+			"code-rule": []byte("---\ntitle: Code\ntags:\n  - scope:code\n---\nbody\n"),
+			"docs-rule": []byte("---\ntitle: Docs\ntags:\n  - scope:docs\n---\nbody\n"),
+		},
+		map[string]map[string]interface{}{
+			"code-rule": {"title": "Code", "tags": []string{"scope:code"}},
+			"docs-rule": {"title": "Docs", "tags": []string{"scope:docs"}},
+		},
+	)
+	counting := &readCountingStore{Store: store}
+	storage.BackendRegistry["main"] = counting
+
+	cfg := &model.Config{
+		DefaultBackend:  "main",
+		StorageBackends: map[string]*model.StorageConfig{"main": {Type: "inmem"}},
+	}
+	svc := NewAppContext(cfg, nil).EntityService
+
+	entities, errs := svc.ListEntitiesMerged("", "scope:code")
+	if len(errs) != 0 {
+		t.Fatalf("ListEntitiesMerged() backend errors = %v", errs)
+	}
+	if len(entities) != 1 || entities[0].Alias != "code-rule" {
+		t.Fatalf("ListEntitiesMerged() = %v, want only code-rule", entities)
+	}
+	if reads := counting.reads.Load(); reads != 0 {
+		t.Errorf("ListEntitiesMerged() with a tag filter made %d Read calls, want 0", reads)
+	}
+}
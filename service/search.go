@@ -0,0 +1,98 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxSearchSnippets caps the snippets returned per entity; MatchCount still counts every matching line.
+const maxSearchSnippets = 3
+
+// maxSnippetLength is the maximum length of a snippet, in characters, before it is trimmed around the match.
+const maxSnippetLength = 160
+
+// SearchMatch is a single body line that matched a search query.
+type SearchMatch struct {
+	Line    int    `json:"line"` // 1-based line number within the body
+	Snippet string `json:"snippet"`
+}
+
+// SearchResult lists the matches of a search query in one entity's body.
+type SearchResult struct {
+	Alias      string        `json:"alias"`
+	Title      string        `json:"title"`
+	Backend    string        `json:"backend"`
+	MatchCount int           `json:"match_count"`
+	Matches    []SearchMatch `json:"matches"`
+}
+
+// SearchEntities scans the bodies of all entities (merged across backends and narrowed by
+// filterString) for query and returns the entities with at least one matching line, sorted by alias.
+// The query is a case-insensitive substring unless useRegex is set, in which case it is a Go
+// regular expression used as-is. Entities that cannot be read are logged and skipped.
+func (s *EntityService) SearchEntities(query string, filterString string, useRegex bool) ([]SearchResult, map[string]error, error) {
+	if query == "" {
+		return nil, nil, fmt.Errorf("search query must not be empty")
+	}
+	pattern := "(?i)" + regexp.QuoteMeta(query)
+	if useRegex {
+		pattern = query
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid search regex '%s': %w", query, err)
+	}
+
+	entities, backendErrors := s.ListEntitiesMerged("", filterString)
+	results := []SearchResult{}
+	for _, listed := range entities {
+		entity, err := s.GetEntity(listed.Alias, listed.SourceBackend)
+		if err != nil {
+			s.ctx.Logger.Warn("Skipping entity that could not be read during search", "alias", listed.Alias, "backend", listed.SourceBackend, "error", err)
+			continue
+		}
+
+		result := SearchResult{Alias: entity.Alias, Title: entity.Title, Backend: entity.SourceBackend}
+		for i, line := range strings.Split(entity.Body, "\n") {
+			loc := re.FindStringIndex(line)
+			if loc == nil {
+				continue
+			}
+			result.MatchCount++
+			if len(result.Matches) < maxSearchSnippets {
+				result.Matches = append(result.Matches, SearchMatch{Line: i + 1, Snippet: snippetAround(line, loc[0], loc[1])})
+			}
+		}
+		if result.MatchCount > 0 {
+			results = append(results, result)
+		}
+	}
+	return results, backendErrors, nil
+}
+
+// snippetAround trims line to at most maxSnippetLength characters, keeping the match at [start, end)
+// in view and marking cut-off text with "...".
+func snippetAround(line string, start, end int) string {
+	line = strings.TrimRight(line, " \t\r")
+	if utf8.RuneCountInString(line) <= maxSnippetLength {
+		return strings.TrimSpace(line)
+	}
+
+	runes := []rune(line)
+	matchStart := utf8.RuneCountInString(line[:start])
+	matchEnd := utf8.RuneCountInString(line[:end])
+	from := max(0, matchStart-(maxSnippetLength-(matchEnd-matchStart))/2)
+	to := min(len(runes), from+maxSnippetLength)
+	from = max(0, to-maxSnippetLength)
+
+	snippet := strings.TrimSpace(string(runes[from:to]))
+	if from > 0 {
+		snippet = "..." + snippet
+	}
+	if to < len(runes) {
+		snippet += "..."
+	}
+	return snippet
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"gydnc/model"
+	"gydnc/storage"
+	"gydnc/storage/inmem"
+)
+
+func newSearchService(t *testing.T) *EntityService {
+	t.Helper()
+	storage.ClearRegistry()
+	t.Cleanup(storage.ClearRegistry)
+
+	store := inmem.NewStore("main")
+	store.LoadEntities(map[string][]byte{
+		"go/errors": []byte("---\ntitle: Errors\ntags:\n  - lang:go\n---\nWrap errors with %w.\nNever ignore an Error.\n"),
+		"go/tests":  []byte("---\ntitle: Tests\ntags:\n  - lang:go\n---\nUse table-driven tests.\n"),
+		"py/errors": []byte("---\ntitle: Python errors\ntags:\n  - lang:python\n---\nRaise specific errors.\n"),
+	}, map[string]map[string]interface{}{
+		"go/errors": {"title": "Errors", "tags": []string{"lang:go"}},
+		"go/tests":  {"title": "Tests", "tags": []string{"lang:go"}},
+		"py/errors": {"title": "Python errors", "tags": []string{"lang:python"}},
+	})
+	storage.BackendRegistry["main"] = store
+
+	cfg := &model.Config{
+		DefaultBackend:  "main",
+		StorageBackends: map[string]*model.StorageConfig{"main": {Type: "inmem"}},
+	}
+	return NewAppContext(cfg, nil).EntityService
+}
+
+func TestSearchEntities(t *testing.T) {
+	svc := newSearchService(t)
+
+	results, _, err := svc.SearchEntities("error", "", false)
+	if err != nil {
+		t.Fatalf("SearchEntities() error = %v", err)
+	}
+	if len(results) != 2 || results[0].Alias != "go/errors" || results[1].Alias != "py/errors" {
+		t.Fatalf("SearchEntities() aliases = %+v, want go/errors and py/errors", results)
+	}
+	if results[0].MatchCount != 2 || results[0].Matches[1].Line != 2 || results[0].Matches[1].Snippet != "Never ignore an Error." {
+		t.Errorf("SearchEntities() go/errors matches = %+v", results[0])
+	}
+
+	results, _, err = svc.SearchEntities("error", "lang:go", false)
+	if err != nil || len(results) != 1 || results[0].Alias != "go/errors" {
+		t.Errorf("SearchEntities() with filter = %+v, %v; want only go/errors", results, err)
+	}
+
+	results, _, err = svc.SearchEntities(`^Use \w+-driven`, "", true)
+	if err != nil || len(results) != 1 || results[0].Alias != "go/tests" {
+		t.Errorf("SearchEntities() with regex = %+v, %v; want only go/tests", results, err)
+	}
+
+	if _, _, err := svc.SearchEntities("(", "", true); err == nil {
+		t.Errorf("SearchEntities() with invalid regex returned no error")
+	}
+}
+
+func TestSnippetAround(t *testing.T) {
+	line := strings.Repeat("a", 200) + "NEEDLE" + strings.Repeat("b", 200)
+	start := strings.Index(line, "NEEDLE")
+	snippet := snippetAround(line, start, start+len("NEEDLE"))
+	if !strings.Contains(snippet, "NEEDLE") || !strings.HasPrefix(snippet, "...") || !strings.HasSuffix(snippet, "...") {
+		t.Errorf("snippetAround() = %q, want the match with both ends trimmed", snippet)
+	}
+	if got := len(strings.Trim(snippet, ".")); got != maxSnippetLength {
+		t.Errorf("snippetAround() length = %d, want %d", got, maxSnippetLength)
+	}
+}
+
+func TestFilterEntitiesByCIDPrefix(t *testing.T) {
+	svc := newSearchService(t)
+	entities, _ := svc.ListEntitiesMerged("", "")
+
+	target, err := svc.GetEntity("go/tests", "main")
+	if err != nil {
+		t.Fatalf("GetEntity() error = %v", err)
+	}
+
+	matched, err := svc.FilterEntitiesByCIDPrefix(entities, strings.ToUpper(target.CID[:7]))
+	if err != nil {
+		t.Fatalf("FilterEntitiesByCIDPrefix() error = %v", err)
+	}
+	if len(matched) != 1 || matched[0].Alias != "go/tests" || matched[0].CID != target.CID {
+		t.Errorf("FilterEntitiesByCIDPrefix() = %+v, want only go/tests with its CID", matched)
+	}
+
+	for _, prefix := range []string{"", "not-hex", strings.Repeat("a", 65)} {
+		if _, err := svc.FilterEntitiesByCIDPrefix(entities, prefix); err == nil {
+			t.Errorf("FilterEntitiesByCIDPrefix(%q) returned no error", prefix)
+		}
+	}
+}
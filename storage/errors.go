@@ -26,4 +26,7 @@ var (
 
 	// ErrAmbiguousBackend is returned when no specific backend is given, no default is set, and multiple backends are available.
 	ErrAmbiguousBackend = errors.New("multiple backends configured and no default is set; ambiguous target backend")
+
+	// ErrAliasCaseCollision is returned when an alias differs only in case from an existing one
+	ErrAliasCaseCollision = errors.New("alias differs only in case from an existing alias")
 )
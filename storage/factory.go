@@ -2,6 +2,7 @@ package storage
 
 import (
 	"fmt"
+	"path/filepath"
 
 	"gydnc/model"
 	"gydnc/storage/inmem"
@@ -12,6 +13,7 @@ import (
 var BackendRegistry = make(map[string]ReadOnlyBackend)
 
 // NewBackendFromConfig creates a new backend based on the provided configuration.
+// Its cases must match model.SupportedBackendTypes.
 // configDir is the directory of the main gydnc config file, used to resolve relative paths in backend configs.
 // Returns the backend interface and any error encountered during initialization.
 func NewBackendFromConfig(name string, cfg *model.StorageConfig, configDir string) (ReadOnlyBackend, error) {
@@ -23,7 +25,7 @@ func NewBackendFromConfig(name string, cfg *model.StorageConfig, configDir strin
 	var err error
 
 	switch cfg.Type {
-	case "localfs":
+	case model.BackendTypeLocalFS:
 		if cfg.LocalFS == nil {
 			return nil, fmt.Errorf("localfs config is required for backend '%s' (type 'localfs')", name)
 		}
@@ -38,14 +40,20 @@ func NewBackendFromConfig(name string, cfg *model.StorageConfig, configDir strin
 		}
 		backend = store
 
-	case "inmem":
-		// InMemStore might not need configDir, but the pattern should be consistent if it ever did.
-		// For now, NewStore doesn't take it.
+	case model.BackendTypeInMem:
 		store := inmem.NewStore(name)
-		// If inmem.Store had an Init that could fail:
-		// if err := store.Init(map[string]interface{}{"name": name}); err != nil {
-		// 	 return nil, fmt.Errorf("failed to initialize inmem backend '%s': %w", name, err)
-		// }
+		initConfig := map[string]interface{}{"name": name}
+		if cfg.InMem != nil && cfg.InMem.SeedDir != "" {
+			// Like a localfs path, a relative seed_dir is resolved against the config file's directory
+			seedDir := cfg.InMem.SeedDir
+			if !filepath.IsAbs(seedDir) && configDir != "" {
+				seedDir = filepath.Join(configDir, seedDir)
+			}
+			initConfig["seed_dir"] = seedDir
+		}
+		if err := store.Init(initConfig); err != nil {
+			return nil, fmt.Errorf("failed to initialize inmem backend '%s': %w", name, err)
+		}
 		backend = store
 
 	default:
@@ -1,16 +1,26 @@
 package inmem
 
 import (
+	"fmt"
 	"io/fs"
+	"os"
+	"path/filepath"
 	"strings"
 	"sync"
+
+	"gydnc/core/content"
 )
 
-// Store implements the storage.ReadOnlyBackend interface for in-memory storage.
+// seedFileExt is the extension of the files loaded from a seed directory.
+const seedFileExt = ".g6e"
+
+// Store implements the storage.ReadOnlyBackend interface for in-memory storage, and the full
+// storage.Backend interface when created with NewWritableStore.
 // This is useful for testing and demonstration purposes.
 type Store struct {
 	name     string
 	entities map[string]entity
+	writable bool
 	mu       sync.RWMutex
 }
 
@@ -27,14 +37,56 @@ func NewStore(name string) *Store {
 	}
 }
 
-// Init initializes the in-memory store.
+// NewWritableStore creates a new in-memory backend that also supports Write and Delete, so
+// create/update/delete flows can be tested without touching disk.
+func NewWritableStore(name string) *Store {
+	store := NewStore(name)
+	store.writable = true
+	return store
+}
+
+// Init initializes the in-memory store. If metadata["seed_dir"] names a directory, every .g6e
+// file below it is loaded, with its path relative to the directory (without the extension)
+// as its alias.
 func (s *Store) Init(metadata map[string]interface{}) error {
 	if name, ok := metadata["name"].(string); ok && name != "" {
 		s.name = name
 	}
+	if seedDir, ok := metadata["seed_dir"].(string); ok && seedDir != "" {
+		return s.loadSeedDir(seedDir)
+	}
 	return nil
 }
 
+// loadSeedDir loads the .g6e files below dir into the store.
+func (s *Store) loadSeedDir(dir string) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return fmt.Errorf("failed to read seed directory: %w", err)
+		}
+		if d.IsDir() || !strings.HasSuffix(d.Name(), seedFileExt) {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read seed file: %w", err)
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		metadata, err := metadataFromContent(data)
+		if err != nil {
+			return fmt.Errorf("failed to parse seed file %s: %w", path, err)
+		}
+		alias := strings.TrimSuffix(filepath.ToSlash(rel), seedFileExt)
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.entities[alias] = entity{content: data, metadata: metadata}
+		return nil
+	})
+}
+
 // GetName returns the name of the backend instance.
 func (s *Store) GetName() string {
 	if s.name == "" {
@@ -105,14 +157,67 @@ func (s *Store) List(prefix string) ([]string, error) {
 
 // IsWritable returns true if this backend supports write operations.
 func (s *Store) IsWritable() bool {
-	return false // This is a read-only backend
+	return s.writable // Only stores created with NewWritableStore are writable
+}
+
+// Write stores data under id. Its title, description and tags are parsed from the frontmatter
+// into the metadata returned by Read and Stat, as with a file-backed store.
+func (s *Store) Write(id string, data []byte, commitMsgDetails map[string]string) error {
+	if !s.writable {
+		return fs.ErrPermission
+	}
+	metadata, err := metadataFromContent(data)
+	if err != nil {
+		return fmt.Errorf("failed to parse G6E content for %s: %w", id, err)
+	}
+	stored := make([]byte, len(data))
+	copy(stored, data)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entities[id] = entity{content: stored, metadata: metadata}
+	return nil
+}
+
+// metadataFromContent returns the title, description, tags and custom frontmatter fields of
+// G6E data as the metadata returned by Read and Stat.
+func metadataFromContent(data []byte) (map[string]interface{}, error) {
+	parsed, err := content.ParseG6E(data)
+	if err != nil {
+		return nil, err
+	}
+	metadata := map[string]interface{}{
+		"title":       parsed.Title,
+		"description": parsed.Description,
+		"tags":        parsed.Tags,
+	}
+	for k, v := range parsed.CustomMetadata {
+		if _, exists := metadata[k]; !exists {
+			metadata[k] = v
+		}
+	}
+	return metadata, nil
+}
+
+// Delete removes the entity stored under id.
+func (s *Store) Delete(id string) error {
+	if !s.writable {
+		return fs.ErrPermission
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.entities[id]; !ok {
+		return fs.ErrNotExist
+	}
+	delete(s.entities, id)
+	return nil
 }
 
 // Capabilities returns a map of capability names to boolean values.
 func (s *Store) Capabilities() map[string]bool {
 	return map[string]bool{
-		"write":  false,
-		"delete": false,
+		"write":  s.writable,
+		"delete": s.writable,
 		"list":   true,
 		"read":   true,
 		"stat":   true,
@@ -0,0 +1,53 @@
+package inmem
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestInitSeedDir(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"alpha.g6e":      "---\ntitle: Alpha\ntags:\n    - scope:code\n---\nalpha\n",
+		"team/rule.g6e":  "---\ntitle: Rule\n---\nrule\n",
+		"notes.txt":      "not guidance",
+		"team/README.md": "# readme",
+	}
+	for name, data := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	store := NewStore("seeded")
+	if err := store.Init(map[string]interface{}{"seed_dir": dir}); err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+
+	ids, _ := store.List("")
+	sort.Strings(ids)
+	if want := []string{"alpha", "team/rule"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("List() = %v, want %v", ids, want)
+	}
+	meta, err := store.Stat("alpha")
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	if meta["title"] != "Alpha" || !reflect.DeepEqual(meta["tags"], []string{"scope:code"}) {
+		t.Errorf("Stat() = %v, want title and tags from the seed file", meta)
+	}
+	if store.IsWritable() {
+		t.Errorf("seeded store is writable, want read-only")
+	}
+
+	if err := NewStore("bad").Init(map[string]interface{}{"seed_dir": filepath.Join(dir, "missing")}); err == nil {
+		t.Errorf("Init() with a missing seed_dir succeeded, want an error")
+	}
+}
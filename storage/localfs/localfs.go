@@ -1,19 +1,40 @@
 package localfs
 
 import (
+	"bytes"
+	"compress/gzip"
+	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"log/slog"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
+	"sync"
+	"time"
 
 	"gydnc/core/content"
+	"gydnc/internal/gitmeta"
 	"gydnc/model"
 	// "gydnc/storage" // REMOVED to break import cycle. Errors like ErrEntityNotFound will be handled by callers or via stdlib errors.
 )
 
-const g6eExt = ".g6e"
+const (
+	g6eExt   = ".g6e"
+	g6eGzExt = ".g6e.gz" // gzip-compressed entity, written when LocalFSConfig.Compress is set
+)
+
+// gzipMagic is the header every gzip stream starts with.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// parseCacheEntry is a parsed entity file together with the file state it was parsed from.
+type parseCacheEntry struct {
+	modTime time.Time
+	size    int64
+	parsed  *content.GuidanceContent
+}
 
 // Store implements the storage.Backend interface for local filesystem storage.
 type Store struct {
@@ -22,8 +43,18 @@ type Store struct {
 	// capabilitiesMap stores the capabilities of this backend instance.
 	// The Capabilities() method from the interface will be used for external access.
 	capabilitiesMap map[string]bool
-	// ignoredFiles []string // Removed, as model.LocalFSConfig does not have IgnoredFiles
-	fsys fs.FS // For testing, allow injecting a filesystem. For real use, os.DirFS(resolvedPath)
+	// ignorePatterns holds filepath.Match glob patterns from model.LocalFSConfig.Ignore.
+	ignorePatterns []string
+	// compress makes Write store entities gzipped as .g6e.gz; Read handles both forms regardless.
+	compress bool
+	// gitAutocommit makes Write and Delete commit the changed entity files if basePath is in a git work tree.
+	gitAutocommit bool
+	// lockTimeout bounds how long Write and Delete wait for another process's lock on an alias.
+	lockTimeout time.Duration
+	// parseCache holds parsed G6E content by file path, reused while the file's mod time and size are unchanged.
+	parseCache   map[string]parseCacheEntry
+	parseCacheMu sync.Mutex
+	fsys         fs.FS // For testing, allow injecting a filesystem. For real use, os.DirFS(resolvedPath)
 }
 
 // NewStore creates a new Store instance for local filesystem operations.
@@ -37,6 +68,22 @@ func NewStore(cfg model.LocalFSConfig, configDir string) (*Store, error) {
 		resolvedPath = filepath.Join(configDir, resolvedPath)
 	}
 
+	// Validate ignore patterns up front so a typo fails loudly instead of silently matching nothing
+	for _, pattern := range cfg.Ignore {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid ignore pattern '%s': %w", pattern, err)
+		}
+	}
+
+	lockTimeout := defaultLockTimeout
+	if cfg.LockTimeout != "" {
+		parsed, err := time.ParseDuration(cfg.LockTimeout)
+		if err != nil || parsed < 0 {
+			return nil, fmt.Errorf("invalid lock_timeout '%s': must be a non-negative duration such as 10s", cfg.LockTimeout)
+		}
+		lockTimeout = parsed
+	}
+
 	// Ensure the base path exists
 	if _, err := os.Stat(resolvedPath); os.IsNotExist(err) {
 		if err := os.MkdirAll(resolvedPath, 0755); err != nil {
@@ -44,9 +91,12 @@ func NewStore(cfg model.LocalFSConfig, configDir string) (*Store, error) {
 		}
 	}
 	return &Store{
-		name:     "localfs", // Default name, can be overridden by SetName
-		basePath: resolvedPath,
-		// ignoredFiles: cfg.IgnoredFiles, // Removed
+		name:           "localfs", // Default name, can be overridden by SetName
+		basePath:       resolvedPath,
+		ignorePatterns: cfg.Ignore,
+		compress:       cfg.Compress,
+		gitAutocommit:  cfg.GitAutocommit,
+		lockTimeout:    lockTimeout,
 		capabilitiesMap: map[string]bool{ // Renamed field
 			"listable":  true,
 			"readable":  true,
@@ -114,43 +164,49 @@ func (s *Store) IsWritable() bool {
 	return true // Default for localfs
 }
 
-// isIgnored checks if a given filename matches any of the ignored patterns.
-// Currently uses simple string equality. Could be expanded to glob patterns.
-// This method was assuming s.ignoredFiles, which has been removed.
-// If ignore functionality is needed, it must be re-implemented based on a proper config source.
-func (s *Store) isIgnored(name string) bool {
-	// for _, pattern := range s.ignoredFiles { // s.ignoredFiles is removed
-	// 	if pattern == name {
-	// 		return true
-	// 	}
-	// }
-	// Example of how it might work if IgnoredFiles were part of model.LocalFSConfig and passed to Store:
-	// if s.config != nil { // Assuming Store had a field like `config model.LocalFSConfig`
-	// 	for _, pattern := range s.config.IgnoredFiles {
-	// 		if pattern == name {
-	// 			return true
-	// 		}
-	// 	}
-	// }
-	return false // Placeholder: No ignore patterns currently configured this way
+// isIgnored checks if an alias matches any of the configured ignore patterns.
+// Patterns are matched with filepath.Match against both the file name (e.g. "draft.g6e")
+// and the slash-separated alias relative to the base path (e.g. "drafts/wip").
+// An alias inside an ignored directory is ignored as well.
+func (s *Store) isIgnored(alias string) bool {
+	fileName := path.Base(alias) + g6eExt
+	for _, pattern := range s.ignorePatterns {
+		if matched, _ := filepath.Match(pattern, fileName); matched {
+			return true
+		}
+		if matched, _ := filepath.Match(pattern, alias); matched {
+			return true
+		}
+	}
+	for dir := path.Dir(alias); dir != "." && dir != "/"; dir = path.Dir(dir) {
+		if s.isIgnoredDir(dir) {
+			return true
+		}
+	}
+	return false
 }
 
 // Read retrieves the content of a guidance entity and its parsed G6E frontmatter as metadata.
 func (s *Store) Read(alias string) ([]byte, map[string]interface{}, error) {
-	fileName := alias + g6eExt
-	if s.isIgnored(fileName) {
+	if s.isIgnored(alias) {
 		return nil, nil, fmt.Errorf("%w: entity is ignored: %s", fs.ErrNotExist, alias)
 	}
-	filePath := filepath.Join(s.basePath, fileName)
-	data, err := os.ReadFile(filePath)
+	filePath, info, err := s.entityFile(alias)
 	if err != nil {
 		if os.IsNotExist(err) {
 			return nil, nil, fs.ErrNotExist // Standard library error
 		}
 		return nil, nil, err
 	}
+	data, err := readEntityData(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil, fs.ErrNotExist
+		}
+		return nil, nil, err
+	}
 
-	parsedG6E, err := content.ParseG6E(data)
+	parsedG6E, err := s.parse(filePath, info, data)
 	if err != nil {
 		// Log parsing error but still return raw content, metadata will be minimal.
 		slog.Warn("Failed to parse G6E frontmatter during Read", "alias", alias, "path", filePath, "error", err)
@@ -162,30 +218,158 @@ func (s *Store) Read(alias string) ([]byte, map[string]interface{}, error) {
 	metadata := map[string]interface{}{
 		"title":       parsedG6E.Title,
 		"description": parsedG6E.Description,
-		"tags":        parsedG6E.Tags, // These are already []string from ParseG6E
-		// Include other known frontmatter fields if necessary, or add them to CustomMetadata
+		"tags":        cloneTags(parsedG6E.Tags), // Copied, since the parsed content may be cached
+	}
+	s.addPathMetadata(metadata, filePath)
+	// Add any non-standard frontmatter fields without overwriting structured fields
+	for k, v := range parsedG6E.CustomMetadata {
+		if _, exists := metadata[k]; !exists {
+			metadata[k] = v
+		}
 	}
-	// Add any other raw frontmatter fields to metadata if ParseG6E exposes them
-	// For example, if parsedG6E.RawFrontmatter is a map[string]interface{}:
-	// for k, v := range parsedG6E.RawFrontmatter {
-	//  if _, exists := metadata[k]; !exists { // Avoid overwriting structured fields
-	//   metadata[k] = v
-	//  }
-	// }
 
 	return data, metadata, nil
 }
 
+// addPathMetadata records the entity's file location: "path" (absolute) and "rel_path"
+// (relative to the backend's base directory, using forward slashes).
+func (s *Store) addPathMetadata(metadata map[string]interface{}, filePath string) {
+	absPath, err := filepath.Abs(filePath)
+	if err != nil {
+		absPath = filePath
+	}
+	relPath, err := filepath.Rel(s.basePath, filePath)
+	if err != nil {
+		relPath = filepath.Base(filePath)
+	}
+	metadata["path"] = absPath
+	metadata["rel_path"] = filepath.ToSlash(relPath)
+}
+
+// entityFilePaths returns the plain and gzipped file paths an alias may be stored at.
+func (s *Store) entityFilePaths(alias string) (plain string, gzipped string) {
+	base := filepath.Join(s.basePath, filepath.FromSlash(alias))
+	return base + g6eExt, base + g6eGzExt
+}
+
+// entityFile locates the file for alias, preferring the plain .g6e file over .g6e.gz.
+func (s *Store) entityFile(alias string) (string, fs.FileInfo, error) {
+	plainPath, gzPath := s.entityFilePaths(alias)
+	info, err := os.Stat(plainPath)
+	if err == nil {
+		return plainPath, info, nil
+	}
+	if !os.IsNotExist(err) {
+		return plainPath, nil, err
+	}
+	info, err = os.Stat(gzPath)
+	return gzPath, info, err
+}
+
+// readEntityData reads an entity file, transparently decompressing gzip content
+// (detected by its magic bytes).
+func readEntityData(filePath string) ([]byte, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.HasPrefix(data, gzipMagic) {
+		data, err = gunzip(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress %s: %w", filePath, err)
+		}
+	}
+	return data, nil
+}
+
+// parse returns the parsed content of filePath, reusing the cached result while the file's
+// mod time and size match info. data is read lazily when it is nil and a parse is needed.
+// Parse failures are not cached. The returned content is shared and must not be mutated.
+func (s *Store) parse(filePath string, info fs.FileInfo, data []byte) (*content.GuidanceContent, error) {
+	s.parseCacheMu.Lock()
+	entry, ok := s.parseCache[filePath]
+	s.parseCacheMu.Unlock()
+	if ok && entry.modTime.Equal(info.ModTime()) && entry.size == info.Size() {
+		return entry.parsed, nil
+	}
+
+	if data == nil {
+		var err error
+		data, err = readEntityData(filePath)
+		if err != nil {
+			return nil, err
+		}
+	}
+	parsed, err := content.ParseG6E(data)
+	if err != nil {
+		return nil, err
+	}
+
+	s.parseCacheMu.Lock()
+	if s.parseCache == nil {
+		s.parseCache = make(map[string]parseCacheEntry)
+	}
+	s.parseCache[filePath] = parseCacheEntry{modTime: info.ModTime(), size: info.Size(), parsed: parsed}
+	s.parseCacheMu.Unlock()
+	return parsed, nil
+}
+
+// invalidateParseCache drops cached parses for the given file paths.
+func (s *Store) invalidateParseCache(filePaths ...string) {
+	s.parseCacheMu.Lock()
+	defer s.parseCacheMu.Unlock()
+	for _, filePath := range filePaths {
+		delete(s.parseCache, filePath)
+	}
+}
+
+func cloneTags(tags []string) []string {
+	if tags == nil {
+		return nil
+	}
+	return append([]string(nil), tags...)
+}
+
+func gunzip(data []byte) ([]byte, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	return io.ReadAll(zr)
+}
+
+func gzipBytes(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
 // Write creates or updates a guidance entity.
 func (s *Store) Write(alias string, data []byte, commitMsgDetails map[string]string) error {
 	if !s.IsWritable() {
 		return fs.ErrPermission // Standard library error for read-only or permission issues
 	}
-	fileName := alias + g6eExt
-	if s.isIgnored(fileName) {
-		return fmt.Errorf("cannot write to ignored entity: %s", alias)
+	if s.isIgnored(alias) {
+		return fmt.Errorf("%w: cannot write to ignored entity: %s", fs.ErrPermission, alias)
+	}
+	filePath, stalePath := s.entityFilePaths(alias)
+	defer s.invalidateParseCache(filePath, stalePath)
+	lockPath := filePath + lockFileExt
+	if s.compress {
+		filePath, stalePath = stalePath, filePath
+		compressed, err := gzipBytes(data)
+		if err != nil {
+			return fmt.Errorf("failed to compress entity '%s': %w", alias, err)
+		}
+		data = compressed
 	}
-	filePath := filepath.Join(s.basePath, fileName)
 	// Ensure the directory for the file exists if alias contains path separators
 	dir := filepath.Dir(filePath)
 	if _, err := os.Stat(dir); os.IsNotExist(err) {
@@ -193,14 +377,127 @@ func (s *Store) Write(alias string, data []byte, commitMsgDetails map[string]str
 			return fmt.Errorf("failed to create directory for entity '%s': %w", alias, err)
 		}
 	}
-	return os.WriteFile(filePath, data, 0644)
+	unlock, err := s.lockAlias(lockPath)
+	if err != nil {
+		return fmt.Errorf("failed to lock entity '%s' for writing: %w", alias, err)
+	}
+	defer unlock()
+	if err := writeFileAtomic(filePath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write entity '%s': %w", alias, err)
+	}
+	// Drop the other representation so an alias never has two diverging files
+	if err := os.Remove(stalePath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove stale file for entity '%s': %w", alias, err)
+	}
+	s.autocommit(alias, commitMessage(alias, commitMsgDetails), filePath, stalePath)
+	return nil
+}
+
+// Lock files serialize writes and deletes of an alias across processes: <alias>.g6e.lock is
+// created exclusively next to the entity file and removed when done. Reads never take the lock.
+const (
+	lockFileExt        = ".lock"
+	defaultLockTimeout = 5 * time.Second
+	lockRetryInterval  = 20 * time.Millisecond
+)
+
+// ErrLockTimeout is returned by Write and Delete when another process holds the alias's lock
+// for longer than the store's lock timeout.
+var ErrLockTimeout = errors.New("timed out waiting for entity lock")
+
+// lockAlias creates lockPath exclusively, retrying until the store's lock timeout, and returns
+// a function that removes it.
+func (s *Store) lockAlias(lockPath string) (func(), error) {
+	deadline := time.Now().Add(s.lockTimeout)
+	for {
+		lockFile, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+		if err == nil {
+			fmt.Fprintf(lockFile, "%d\n", os.Getpid())
+			lockFile.Close()
+			return func() {
+				if err := os.Remove(lockPath); err != nil && !os.IsNotExist(err) {
+					slog.Warn("Failed to remove entity lock file", "path", lockPath, "error", err)
+				}
+			}, nil
+		}
+		if !os.IsExist(err) {
+			return nil, err
+		}
+		if !time.Now().Before(deadline) {
+			return nil, fmt.Errorf("%w: %s is held by another process (waited %s); if no other gydnc process is running, remove it", ErrLockTimeout, lockPath, s.lockTimeout)
+		}
+		time.Sleep(lockRetryInterval)
+	}
+}
+
+// writeFileAtomic writes data to a temporary file next to path and renames it into place, so
+// a crash mid-write never leaves a truncated file at path. The temporary file, named
+// <file>.tmp-<random> (matched by the .gitignore written by 'gydnc init --gitignore'), is
+// removed if any step fails.
+func writeFileAtomic(path string, data []byte, perm os.FileMode) (err error) {
+	tmpFile, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	tmpPath := tmpFile.Name()
+	defer func() {
+		if err != nil {
+			tmpFile.Close()
+			os.Remove(tmpPath)
+		}
+	}()
+
+	if _, err = tmpFile.Write(data); err != nil {
+		return err
+	}
+	if err = tmpFile.Sync(); err != nil {
+		return err
+	}
+	if err = tmpFile.Chmod(perm); err != nil {
+		return err
+	}
+	if err = tmpFile.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// commitMessage builds a git commit message from the action, alias and reason keys of commitMsgDetails.
+func commitMessage(alias string, commitMsgDetails map[string]string) string {
+	action := commitMsgDetails["action"]
+	if action == "" {
+		action = "write"
+	}
+	if detailAlias := commitMsgDetails["alias"]; detailAlias != "" {
+		alias = detailAlias
+	}
+	message := fmt.Sprintf("gydnc: %s %s", action, alias)
+	if reason := commitMsgDetails["reason"]; reason != "" {
+		message += "\n\n" + reason
+	}
+	return message
+}
+
+// autocommit commits the given entity files when git_autocommit is enabled. Failures, including
+// a base path outside a git work tree, are logged as warnings since the write itself succeeded.
+func (s *Store) autocommit(alias string, message string, paths ...string) {
+	if !s.gitAutocommit {
+		return
+	}
+	committed, err := gitmeta.CommitPaths(s.basePath, message, paths...)
+	if err != nil {
+		slog.Warn("git autocommit failed", "backend", s.name, "alias", alias, "error", err)
+		return
+	}
+	slog.Debug("git autocommit", "backend", s.name, "alias", alias, "committed", committed)
 }
 
 // List retrieves a list of all guidance entity aliases (filenames without .g6e).
-// The prefix parameter is not deeply implemented here yet for hierarchical listing;
-// it currently lists all .g6e files under basePath.
+// If prefix is set, only aliases starting with it are returned, and directories
+// that cannot contain such aliases are skipped entirely rather than walked.
 func (s *Store) List(prefix string) ([]string, error) {
 	var aliases []string
+	seen := make(map[string]bool) // An alias may exist as both .g6e and .g6e.gz
 	// Convert basepath to use OS-specific separators for WalkDir
 	searchPath := filepath.FromSlash(s.basePath)
 
@@ -216,22 +513,40 @@ func (s *Store) List(prefix string) ([]string, error) {
 			return nil // Continue walking if it's a non-critical error on a specific file/dir
 		}
 
-		// Process only files, not directories
-		if !d.IsDir() {
-			// Check if it's a .g6e file
-			if strings.HasSuffix(d.Name(), ".g6e") {
-				// Calculate alias relative to the basePath
-				relPath, err := filepath.Rel(searchPath, path)
-				if err != nil {
-					slog.Warn("Could not determine relative path for List operation", "basePath", searchPath, "filePath", path, "error", err)
-					return nil // Continue walking
+		// Prune directory trees that are ignored or cannot contain aliases matching the prefix
+		if d.IsDir() {
+			if path != searchPath {
+				relDir, err := filepath.Rel(searchPath, path)
+				if err == nil && s.isIgnoredDir(filepath.ToSlash(relDir)) {
+					return filepath.SkipDir
 				}
-				alias := strings.TrimSuffix(filepath.ToSlash(relPath), ".g6e") // Use ToSlash for consistent alias format
-				if !s.isIgnored(d.Name()) {                                    // Check if the original filename would be ignored
-					// Apply prefix filter if present
-					if prefix == "" || strings.HasPrefix(alias, prefix) {
-						aliases = append(aliases, alias)
-					}
+				if err == nil && prefix != "" && !dirMatchesPrefix(filepath.ToSlash(relDir), prefix) {
+					return filepath.SkipDir
+				}
+			}
+			return nil
+		}
+
+		// Check if it's a .g6e or .g6e.gz file
+		ext := ""
+		if strings.HasSuffix(d.Name(), g6eExt) {
+			ext = g6eExt
+		} else if strings.HasSuffix(d.Name(), g6eGzExt) {
+			ext = g6eGzExt
+		}
+		if ext != "" {
+			// Calculate alias relative to the basePath
+			relPath, err := filepath.Rel(searchPath, path)
+			if err != nil {
+				slog.Warn("Could not determine relative path for List operation", "basePath", searchPath, "filePath", path, "error", err)
+				return nil // Continue walking
+			}
+			alias := strings.TrimSuffix(filepath.ToSlash(relPath), ext) // Use ToSlash for consistent alias format
+			if !s.isIgnored(alias) && !seen[alias] {
+				// Apply prefix filter if present
+				if prefix == "" || strings.HasPrefix(alias, prefix) {
+					seen[alias] = true
+					aliases = append(aliases, alias)
 				}
 			}
 		}
@@ -246,6 +561,28 @@ func (s *Store) List(prefix string) ([]string, error) {
 	return aliases, nil
 }
 
+// isIgnoredDir checks if a directory (slash-separated, relative to the base path)
+// matches an ignore pattern, in which case its whole tree is skipped by List.
+func (s *Store) isIgnoredDir(relDir string) bool {
+	for _, pattern := range s.ignorePatterns {
+		if matched, _ := filepath.Match(pattern, relDir); matched {
+			return true
+		}
+		if matched, _ := filepath.Match(pattern, path.Base(relDir)); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// dirMatchesPrefix reports whether the directory relDir (slash-separated, relative
+// to the base path) may hold aliases starting with prefix: either the directory
+// lies under the prefix, or the prefix continues down into the directory.
+func dirMatchesPrefix(relDir string, prefix string) bool {
+	dirPrefix := relDir + "/"
+	return strings.HasPrefix(dirPrefix, prefix) || strings.HasPrefix(prefix, dirPrefix)
+}
+
 // Delete removes a guidance entity file.
 func (s *Store) Delete(alias string) error {
 	if !s.IsWritable() { // Or check a specific "deletable" capability
@@ -255,47 +592,59 @@ func (s *Store) Delete(alias string) error {
 	if !ok || !canDelete {
 		return fmt.Errorf("%w: delete operation not supported by backend '%s'", fs.ErrPermission, s.name)
 	}
-	fileName := alias + g6eExt
-	if s.isIgnored(fileName) {
-		return fmt.Errorf("cannot delete ignored entity: %s", alias)
+	if s.isIgnored(alias) {
+		return fmt.Errorf("%w: entity is ignored: %s", fs.ErrNotExist, alias)
+	}
+	// Remove both representations; the entity exists if either was there
+	removed := false
+	plainPath, gzPath := s.entityFilePaths(alias)
+	defer s.invalidateParseCache(plainPath, gzPath)
+	unlock, err := s.lockAlias(plainPath + lockFileExt)
+	if errors.Is(err, fs.ErrNotExist) {
+		return fs.ErrNotExist // The alias's directory does not exist, so neither does the entity
 	}
-	filePath := filepath.Join(s.basePath, fileName)
-	err := os.Remove(filePath)
 	if err != nil {
-		if os.IsNotExist(err) {
-			return fs.ErrNotExist // Standard library error
+		return fmt.Errorf("failed to lock entity '%s' for deletion: %w", alias, err)
+	}
+	defer unlock()
+	for _, filePath := range []string{plainPath, gzPath} {
+		err := os.Remove(filePath)
+		if err == nil {
+			removed = true
+		} else if !os.IsNotExist(err) {
+			return err
 		}
-		return err
 	}
+	if !removed {
+		return fs.ErrNotExist // Standard library error
+	}
+	s.autocommit(alias, commitMessage(alias, map[string]string{"action": "delete"}), plainPath, gzPath)
 	return nil
 }
 
 // Stat retrieves metadata about a guidance entity, including parsed G6E frontmatter.
 func (s *Store) Stat(alias string) (map[string]interface{}, error) {
-	fileName := alias + g6eExt
-	if s.isIgnored(fileName) {
+	if s.isIgnored(alias) {
 		return nil, fmt.Errorf("%w: entity is ignored: %s", fs.ErrNotExist, alias)
 	}
-	filePath := filepath.Join(s.basePath, fileName)
 
-	// Read file content to parse frontmatter
-	data, err := os.ReadFile(filePath)
+	filePath, fileInfo, err := s.entityFile(alias)
 	if err != nil {
 		if os.IsNotExist(err) {
 			return nil, fs.ErrNotExist
 		}
-		return nil, fmt.Errorf("failed to read file for Stat %s: %w", alias, err)
+		return nil, fmt.Errorf("failed to stat file for Stat %s: %w", alias, err)
 	}
 
-	parsedG6E, err := content.ParseG6E(data)
+	// Parse frontmatter, reading the file only if the cached parse is stale
+	parsedG6E, err := s.parse(filePath, fileInfo, nil)
+	if os.IsNotExist(err) {
+		return nil, fs.ErrNotExist
+	}
 	if err != nil {
 		// Log parsing error but proceed with basic file info if G6E parsing fails.
 		slog.Warn("Failed to parse G6E frontmatter during Stat", "alias", alias, "path", filePath, "error", err)
 		// Fallback to basic file info if parsing fails
-		fileInfo, statErr := os.Stat(filePath)
-		if statErr != nil { // This shouldn't happen if ReadFile succeeded, but good to check.
-			return nil, fmt.Errorf("failed to stat file after G6E parse error for %s: %w", alias, statErr)
-		}
 		return map[string]interface{}{
 			"name":     fileInfo.Name(),
 			"size":     fileInfo.Size(),
@@ -309,17 +658,20 @@ func (s *Store) Stat(alias string) (map[string]interface{}, error) {
 	metadata := map[string]interface{}{
 		"title":       parsedG6E.Title,
 		"description": parsedG6E.Description,
-		"tags":        parsedG6E.Tags,          // These are already []string from ParseG6E
-		"name":        filepath.Base(filePath), // Keep basic file info too
-		// "size": // Size might be misleading if we only care about frontmatter for Stat.
-		// "mod_time": // ModTime might still be relevant.
-	}
-	// If ParseG6E provided other frontmatter fields in a map, merge them here.
-	// e.g., if parsedG6E.OtherFrontmatter exists:
-	// for k, v := range parsedG6E.OtherFrontmatter {
-	//  if _, exists := metadata[k]; !exists {
-	//   metadata[k] = v
-	//  }
-	// }
+		"tags":        cloneTags(parsedG6E.Tags), // Copied, since the parsed content may be cached
+		"name":        filepath.Base(filePath),   // Keep basic file info too
+		"size":        fileInfo.Size(),           // Size of the file as stored (compressed for .g6e.gz)
+		"mod_time":    fileInfo.ModTime(),
+	}
+	if cid, err := parsedG6E.GetContentID(); err == nil {
+		metadata["cid"] = cid
+	}
+	s.addPathMetadata(metadata, filePath)
+	// Merge non-standard frontmatter fields without overwriting structured fields
+	for k, v := range parsedG6E.CustomMetadata {
+		if _, exists := metadata[k]; !exists {
+			metadata[k] = v
+		}
+	}
 	return metadata, nil
 }
@@ -0,0 +1,559 @@
+package localfs
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"gydnc/model"
+)
+
+// newNestedStore creates a store over a nested fixture layout:
+//
+//	top.g6e
+//	scope/code/rule.g6e
+//	scope/code/style/naming.g6e
+//	scope/codex/other.g6e
+//	scope/docs/readme.g6e
+//	unrelated/deep/tree/entry.g6e
+func newNestedStore(t *testing.T) *Store {
+	t.Helper()
+	baseDir := t.TempDir()
+	files := []string{
+		"top.g6e",
+		"scope/code/rule.g6e",
+		"scope/code/style/naming.g6e",
+		"scope/codex/other.g6e",
+		"scope/docs/readme.g6e",
+		"unrelated/deep/tree/entry.g6e",
+	}
+	for _, f := range files {
+		fullPath := filepath.Join(baseDir, filepath.FromSlash(f))
+		if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+			t.Fatalf("failed to create fixture dir for %s: %v", f, err)
+		}
+		if err := os.WriteFile(fullPath, []byte("---\ntitle: fixture\n---\nbody\n"), 0644); err != nil {
+			t.Fatalf("failed to write fixture %s: %v", f, err)
+		}
+	}
+
+	store, err := NewStore(model.LocalFSConfig{Path: baseDir}, "")
+	if err != nil {
+		t.Fatalf("NewStore() error = %v", err)
+	}
+	return store
+}
+
+func TestStore_ListPrefix(t *testing.T) {
+	store := newNestedStore(t)
+
+	tests := []struct {
+		name     string
+		prefix   string
+		expected []string
+	}{
+		{
+			name:   "empty prefix lists everything",
+			prefix: "",
+			expected: []string{
+				"scope/code/rule",
+				"scope/code/style/naming",
+				"scope/codex/other",
+				"scope/docs/readme",
+				"top",
+				"unrelated/deep/tree/entry",
+			},
+		},
+		{
+			name:     "directory prefix with trailing slash",
+			prefix:   "scope/code/",
+			expected: []string{"scope/code/rule", "scope/code/style/naming"},
+		},
+		{
+			name:     "partial directory name matches sibling directories",
+			prefix:   "scope/code",
+			expected: []string{"scope/code/rule", "scope/code/style/naming", "scope/codex/other"},
+		},
+		{
+			name:     "prefix inside a nested directory",
+			prefix:   "scope/code/style/na",
+			expected: []string{"scope/code/style/naming"},
+		},
+		{
+			name:     "top-level file prefix",
+			prefix:   "to",
+			expected: []string{"top"},
+		},
+		{
+			name:     "no matches",
+			prefix:   "missing/",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			aliases, err := store.List(tt.prefix)
+			if err != nil {
+				t.Fatalf("List(%q) error = %v", tt.prefix, err)
+			}
+			sort.Strings(aliases)
+			if !reflect.DeepEqual(aliases, tt.expected) {
+				t.Errorf("List(%q) = %v, want %v", tt.prefix, aliases, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDirMatchesPrefix(t *testing.T) {
+	tests := []struct {
+		relDir string
+		prefix string
+		want   bool
+	}{
+		{relDir: "scope", prefix: "scope/code/", want: true},
+		{relDir: "scope/code", prefix: "scope/code/", want: true},
+		{relDir: "scope/code/style", prefix: "scope/code/", want: true},
+		{relDir: "scope/codex", prefix: "scope/code/", want: false},
+		{relDir: "scope/codex", prefix: "scope/code", want: true},
+		{relDir: "unrelated", prefix: "scope/code/", want: false},
+		{relDir: "unrelated/deep", prefix: "scope/", want: false},
+		{relDir: "sc", prefix: "scope/", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := dirMatchesPrefix(tt.relDir, tt.prefix); got != tt.want {
+			t.Errorf("dirMatchesPrefix(%q, %q) = %v, want %v", tt.relDir, tt.prefix, got, tt.want)
+		}
+	}
+}
+
+func TestStore_IgnorePatterns(t *testing.T) {
+	baseDir := t.TempDir()
+	files := []string{
+		"keep.g6e",
+		"wip.draft.g6e",
+		"drafts/idea.g6e",
+		"scope/notes.g6e",
+	}
+	for _, f := range files {
+		fullPath := filepath.Join(baseDir, filepath.FromSlash(f))
+		if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+			t.Fatalf("failed to create fixture dir for %s: %v", f, err)
+		}
+		if err := os.WriteFile(fullPath, []byte("---\ntitle: fixture\n---\nbody\n"), 0644); err != nil {
+			t.Fatalf("failed to write fixture %s: %v", f, err)
+		}
+	}
+
+	store, err := NewStore(model.LocalFSConfig{
+		Path:   baseDir,
+		Ignore: []string{"*.draft.g6e", "drafts", "scope/notes"},
+	}, "")
+	if err != nil {
+		t.Fatalf("NewStore() error = %v", err)
+	}
+
+	aliases, err := store.List("")
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if !reflect.DeepEqual(aliases, []string{"keep"}) {
+		t.Errorf("List() = %v, want [keep]", aliases)
+	}
+
+	for _, alias := range []string{"wip.draft", "drafts/idea", "scope/notes"} {
+		if _, _, err := store.Read(alias); !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("Read(%q) error = %v, want fs.ErrNotExist", alias, err)
+		}
+		if _, err := store.Stat(alias); !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("Stat(%q) error = %v, want fs.ErrNotExist", alias, err)
+		}
+		if err := store.Delete(alias); !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("Delete(%q) error = %v, want fs.ErrNotExist", alias, err)
+		}
+	}
+
+	if err := store.Write("another.draft", []byte("---\ntitle: x\n---\n"), nil); !errors.Is(err, fs.ErrPermission) {
+		t.Errorf("Write() on ignored alias error = %v, want fs.ErrPermission", err)
+	}
+	if _, err := os.Stat(filepath.Join(baseDir, "another.draft.g6e")); !os.IsNotExist(err) {
+		t.Errorf("Write() on ignored alias created a file")
+	}
+	if _, err := os.Stat(filepath.Join(baseDir, "drafts", "idea.g6e")); err != nil {
+		t.Errorf("Delete() on ignored alias removed the file: %v", err)
+	}
+}
+
+func TestNewStore_InvalidIgnorePattern(t *testing.T) {
+	_, err := NewStore(model.LocalFSConfig{Path: t.TempDir(), Ignore: []string{"[unclosed"}}, "")
+	if err == nil {
+		t.Fatal("NewStore() expected error for malformed ignore pattern, got nil")
+	}
+}
+
+func TestStore_ReadPathMetadata(t *testing.T) {
+	store := newNestedStore(t)
+
+	_, metadata, err := store.Read("scope/code/rule")
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if got := metadata["rel_path"]; got != "scope/code/rule.g6e" {
+		t.Errorf("rel_path = %v, want scope/code/rule.g6e", got)
+	}
+	wantPath := filepath.Join(store.basePath, "scope", "code", "rule.g6e")
+	if got, _ := metadata["path"].(string); !filepath.IsAbs(got) || got != wantPath {
+		t.Errorf("path = %v, want absolute %s", got, wantPath)
+	}
+}
+
+func TestStore_StatFileInfo(t *testing.T) {
+	store := newNestedStore(t)
+
+	metadata, err := store.Stat("scope/code/rule")
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	info, err := os.Stat(filepath.Join(store.basePath, "scope", "code", "rule.g6e"))
+	if err != nil {
+		t.Fatalf("os.Stat() error = %v", err)
+	}
+	if got := metadata["size"]; got != info.Size() {
+		t.Errorf("size = %v, want %d", got, info.Size())
+	}
+	if got, _ := metadata["mod_time"].(time.Time); !got.Equal(info.ModTime()) {
+		t.Errorf("mod_time = %v, want %v", got, info.ModTime())
+	}
+	wantCID := fmt.Sprintf("%x", sha256.Sum256([]byte("body\n")))
+	if got := metadata["cid"]; got != wantCID {
+		t.Errorf("cid = %v, want %s", got, wantCID)
+	}
+
+	// The fallback for unparseable frontmatter reports the same file properties
+	brokenPath := filepath.Join(store.basePath, "broken.g6e")
+	if err := os.WriteFile(brokenPath, []byte("---\ntitle: [unclosed\n---\nbody\n"), 0644); err != nil {
+		t.Fatalf("failed to write fixture: %v", err)
+	}
+	metadata, err = store.Stat("broken")
+	if err != nil {
+		t.Fatalf("Stat(broken) error = %v", err)
+	}
+	if _, ok := metadata["g6e_parse_error"]; !ok {
+		t.Fatalf("Stat(broken) = %v, want g6e_parse_error", metadata)
+	}
+	if _, ok := metadata["size"].(int64); !ok {
+		t.Errorf("Stat(broken) size = %v, want int64", metadata["size"])
+	}
+	if _, ok := metadata["mod_time"].(time.Time); !ok {
+		t.Errorf("Stat(broken) mod_time = %v, want time.Time", metadata["mod_time"])
+	}
+}
+
+func TestStore_AtomicWrite(t *testing.T) {
+	baseDir := t.TempDir()
+	store, err := NewStore(model.LocalFSConfig{Path: baseDir}, "")
+	if err != nil {
+		t.Fatalf("NewStore() error = %v", err)
+	}
+
+	// tmpFiles returns the leftover temporary files in dir
+	tmpFiles := func(dir string) []string {
+		matches, err := filepath.Glob(filepath.Join(dir, "*.tmp-*"))
+		if err != nil {
+			t.Fatalf("Glob() error = %v", err)
+		}
+		return matches
+	}
+
+	data := []byte("---\ntitle: Nested\n---\nbody\n")
+	if err := store.Write("scope/new/rule", data, nil); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	filePath := filepath.Join(baseDir, "scope", "new", "rule.g6e")
+	got, err := os.ReadFile(filePath)
+	if err != nil || string(got) != string(data) {
+		t.Fatalf("written file = %q, %v; want %q", got, err, data)
+	}
+	info, err := os.Stat(filePath)
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	if mode := info.Mode().Perm(); mode != 0644 {
+		t.Errorf("file mode = %o, want 644", mode)
+	}
+	if leftover := tmpFiles(filepath.Dir(filePath)); len(leftover) != 0 {
+		t.Errorf("temporary files left after Write(): %v", leftover)
+	}
+
+	// A directory in the way makes the final rename fail; the temporary file must not remain
+	if err := os.MkdirAll(filepath.Join(baseDir, "clash.g6e", "inner"), 0755); err != nil {
+		t.Fatalf("failed to create clashing directory: %v", err)
+	}
+	if err := store.Write("clash", data, nil); err == nil {
+		t.Fatal("Write() over a directory succeeded, want an error")
+	}
+	if leftover := tmpFiles(baseDir); len(leftover) != 0 {
+		t.Errorf("temporary files left after failed Write(): %v", leftover)
+	}
+}
+
+func TestStore_AliasLocking(t *testing.T) {
+	baseDir := t.TempDir()
+	store, err := NewStore(model.LocalFSConfig{Path: baseDir, LockTimeout: "100ms"}, "")
+	if err != nil {
+		t.Fatalf("NewStore() error = %v", err)
+	}
+	data := []byte("---\ntitle: Locked\n---\nbody\n")
+	if err := store.Write("rule", data, nil); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	lockPath := filepath.Join(baseDir, "rule.g6e.lock")
+	if _, err := os.Stat(lockPath); !os.IsNotExist(err) {
+		t.Fatalf("lock file left after Write(): %v", err)
+	}
+
+	// Another process holds the lock: writes and deletes time out, reads are not blocked
+	if err := os.WriteFile(lockPath, []byte("12345\n"), 0644); err != nil {
+		t.Fatalf("failed to create lock file: %v", err)
+	}
+	if err := store.Write("rule", data, nil); !errors.Is(err, ErrLockTimeout) {
+		t.Errorf("Write() with the alias locked error = %v, want ErrLockTimeout", err)
+	}
+	if err := store.Delete("rule"); !errors.Is(err, ErrLockTimeout) {
+		t.Errorf("Delete() with the alias locked error = %v, want ErrLockTimeout", err)
+	}
+	if _, _, err := store.Read("rule"); err != nil {
+		t.Errorf("Read() with the alias locked error = %v, want nil", err)
+	}
+	if err := store.Write("other", data, nil); err != nil {
+		t.Errorf("Write() of another alias error = %v, want nil", err)
+	}
+
+	// Once released, concurrent writers of the same alias all succeed, one at a time
+	if err := os.Remove(lockPath); err != nil {
+		t.Fatalf("failed to remove lock file: %v", err)
+	}
+	slowStore, err := NewStore(model.LocalFSConfig{Path: baseDir, LockTimeout: "10s"}, "")
+	if err != nil {
+		t.Fatalf("NewStore() error = %v", err)
+	}
+	errs := make(chan error, 8)
+	for i := 0; i < cap(errs); i++ {
+		go func(i int) {
+			errs <- slowStore.Write("rule", []byte(fmt.Sprintf("---\ntitle: Writer %d\n---\nbody\n", i)), nil)
+		}(i)
+	}
+	for i := 0; i < cap(errs); i++ {
+		if err := <-errs; err != nil {
+			t.Errorf("concurrent Write() error = %v", err)
+		}
+	}
+	if _, err := os.Stat(lockPath); !os.IsNotExist(err) {
+		t.Errorf("lock file left after concurrent writes: %v", err)
+	}
+
+	if _, err := NewStore(model.LocalFSConfig{Path: baseDir, LockTimeout: "soon"}, ""); err == nil {
+		t.Error("NewStore() with an invalid lock_timeout succeeded, want an error")
+	}
+}
+
+func TestStore_CompressedEntities(t *testing.T) {
+	baseDir := t.TempDir()
+	g6e := []byte("---\ntitle: Zipped\n---\nbody\n")
+
+	compressed, err := NewStore(model.LocalFSConfig{Path: baseDir, Compress: true}, "")
+	if err != nil {
+		t.Fatalf("NewStore() error = %v", err)
+	}
+	if err := compressed.Write("scope/zipped", g6e, nil); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	raw, err := os.ReadFile(filepath.Join(baseDir, "scope", "zipped.g6e.gz"))
+	if err != nil {
+		t.Fatalf("expected scope/zipped.g6e.gz to be written: %v", err)
+	}
+	if len(raw) < 2 || raw[0] != 0x1f || raw[1] != 0x8b {
+		t.Errorf("written file is not gzip-compressed")
+	}
+
+	// A store without compress still reads compressed entities and lists them by alias
+	plain, err := NewStore(model.LocalFSConfig{Path: baseDir}, "")
+	if err != nil {
+		t.Fatalf("NewStore() error = %v", err)
+	}
+	data, metadata, err := plain.Read("scope/zipped")
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if string(data) != string(g6e) || metadata["title"] != "Zipped" || metadata["rel_path"] != "scope/zipped.g6e.gz" {
+		t.Errorf("Read() = %q, %v", data, metadata)
+	}
+
+	// Rewriting uncompressed replaces the .g6e.gz so the alias is listed once
+	if err := plain.Write("scope/zipped", g6e, nil); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(baseDir, "scope", "zipped.g6e.gz")); !os.IsNotExist(err) {
+		t.Errorf("stale .g6e.gz was not removed: %v", err)
+	}
+	if err := compressed.Write("other", g6e, nil); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	aliases, err := plain.List("")
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	sort.Strings(aliases)
+	if !reflect.DeepEqual(aliases, []string{"other", "scope/zipped"}) {
+		t.Errorf("List() = %v", aliases)
+	}
+
+	if err := plain.Delete("other"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if err := plain.Delete("other"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("second Delete() error = %v, want fs.ErrNotExist", err)
+	}
+}
+
+func TestStore_ParseCacheInvalidation(t *testing.T) {
+	store := newNestedStore(t)
+	alias := "scope/code/rule"
+
+	if _, err := store.Stat(alias); err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	filePath := filepath.Join(store.basePath, "scope", "code", "rule.g6e")
+	if _, ok := store.parseCache[filePath]; !ok {
+		t.Fatalf("Stat() did not cache the parsed file")
+	}
+
+	// Write invalidates the cache entry
+	if err := store.Write(alias, []byte("---\ntitle: rewritten\n---\nbody\n"), nil); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if _, ok := store.parseCache[filePath]; ok {
+		t.Errorf("Write() did not invalidate the cache entry")
+	}
+	metadata, err := store.Stat(alias)
+	if err != nil || metadata["title"] != "rewritten" {
+		t.Fatalf("Stat() after Write = %v, %v; want title rewritten", metadata, err)
+	}
+
+	// An out-of-band edit changes size/mod time and is picked up without Write
+	if err := os.WriteFile(filePath, []byte("---\ntitle: edited outside gydnc\n---\nbody\n"), 0644); err != nil {
+		t.Fatalf("failed to edit fixture: %v", err)
+	}
+	metadata, err = store.Stat(alias)
+	if err != nil || metadata["title"] != "edited outside gydnc" {
+		t.Fatalf("Stat() after external edit = %v, %v", metadata, err)
+	}
+
+	// Delete invalidates the cache entry
+	if err := store.Delete(alias); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, ok := store.parseCache[filePath]; ok {
+		t.Errorf("Delete() did not invalidate the cache entry")
+	}
+	if _, err := store.Stat(alias); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Stat() after Delete error = %v, want fs.ErrNotExist", err)
+	}
+}
+
+// BenchmarkStore_ListAndStat measures a list-then-stat pass, as done by `gydnc list`,
+// over a store of 500 entities; repeated passes are served from the parse cache.
+func BenchmarkStore_ListAndStat(b *testing.B) {
+	baseDir := b.TempDir()
+	body := strings.Repeat("Guidance body line.\n", 200)
+	for i := 0; i < 500; i++ {
+		g6e := fmt.Sprintf("---\ntitle: Entity %d\ntags:\n  - bench\n---\n%s", i, body)
+		if err := os.WriteFile(filepath.Join(baseDir, fmt.Sprintf("entity-%03d.g6e", i)), []byte(g6e), 0644); err != nil {
+			b.Fatalf("failed to write fixture: %v", err)
+		}
+	}
+	store, err := NewStore(model.LocalFSConfig{Path: baseDir}, "")
+	if err != nil {
+		b.Fatalf("NewStore() error = %v", err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		aliases, err := store.List("")
+		if err != nil {
+			b.Fatalf("List() error = %v", err)
+		}
+		for _, alias := range aliases {
+			if _, err := store.Stat(alias); err != nil {
+				b.Fatalf("Stat() error = %v", err)
+			}
+		}
+	}
+}
+
+func TestStore_GitAutocommit(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not installed")
+	}
+	baseDir := t.TempDir()
+	for _, args := range [][]string{
+		{"init", "-q"},
+		{"config", "user.email", "test@example.com"},
+		{"config", "user.name", "Test"},
+		{"config", "commit.gpgsign", "false"},
+	} {
+		if out, err := exec.Command("git", append([]string{"-C", baseDir}, args...)...).CombinedOutput(); err != nil {
+			t.Fatalf("git %v failed: %v: %s", args, err, out)
+		}
+	}
+
+	store, err := NewStore(model.LocalFSConfig{Path: baseDir, GitAutocommit: true}, "")
+	if err != nil {
+		t.Fatalf("NewStore() error = %v", err)
+	}
+	if err := store.Write("scope/rule", []byte("---\ntitle: Rule\n---\nbody\n"), map[string]string{"action": "save", "alias": "scope/rule", "reason": "initial import"}); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if err := store.Delete("scope/rule"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	out, err := exec.Command("git", "-C", baseDir, "log", "--format=%B%x00").Output()
+	if err != nil {
+		t.Fatalf("git log failed: %v", err)
+	}
+	var messages []string
+	for _, message := range strings.Split(string(out), "\x00") {
+		if message = strings.TrimSpace(message); message != "" {
+			messages = append(messages, message)
+		}
+	}
+	want := []string{"gydnc: delete scope/rule", "gydnc: save scope/rule\n\ninitial import"}
+	if !reflect.DeepEqual(messages, want) {
+		t.Errorf("commit messages = %q, want %q", messages, want)
+	}
+}
+
+func TestStore_GitAutocommitOutsideRepository(t *testing.T) {
+	store, err := NewStore(model.LocalFSConfig{Path: t.TempDir(), GitAutocommit: true}, "")
+	if err != nil {
+		t.Fatalf("NewStore() error = %v", err)
+	}
+	// Not a git repository: the write must still succeed, the commit failure is only a warning
+	if err := store.Write("rule", []byte("---\ntitle: Rule\n---\nbody\n"), nil); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if _, err := store.Stat("rule"); err != nil {
+		t.Errorf("Stat() after Write error = %v", err)
+	}
+}
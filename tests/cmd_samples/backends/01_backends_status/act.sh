@@ -0,0 +1,20 @@
+#!/bin/bash
+set -e
+
+cat > config.yml <<EOF2
+default_backend: main
+storage_backends:
+  main:
+    type: localfs
+    localfs:
+      path: ./main
+  remote:
+    type: s3
+EOF2
+mkdir -p main
+export GYDNC_CONFIG=./config.yml
+
+echo "---Table---"
+./gydnc backends 2>/dev/null | sed "s#$(pwd)#<dir>#" | tr -s ' '
+echo "---JSON---"
+./gydnc backends --output json 2>/dev/null | sed "s#$(pwd)#<dir>#"
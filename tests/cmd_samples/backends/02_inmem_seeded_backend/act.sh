@@ -0,0 +1,23 @@
+#!/bin/bash
+set -e
+
+mkdir -p seed/team
+printf -- '---\ntitle: Demo\ntags:\n    - scope:demo\n---\nDemo body.\n' > seed/demo.g6e
+printf -- '---\ntitle: Team Rule\n---\nRule body.\n' > seed/team/rule.g6e
+
+cat > config.yml <<EOF2
+default_backend: demo
+storage_backends:
+  demo:
+    type: inmem
+    inmem:
+      seed_dir: seed
+EOF2
+export GYDNC_CONFIG=./config.yml
+
+./gydnc list --aliases-only
+./gydnc get demo --output raw
+echo "---Read-only---"
+./gydnc create new --body "x" </dev/null 2>&1 | grep -v '^level=' || true
+echo "---Backends---"
+./gydnc backends | tr -s ' '
@@ -0,0 +1,17 @@
+#!/bin/bash
+set -e
+
+# No default backend and a broken backend: notices must not end up in the JSON on stdout
+cat > config.yml <<EOF2
+storage_backends:
+  main:
+    type: localfs
+    localfs:
+      path: ./main
+  remote:
+    type: s3
+EOF2
+mkdir -p main
+export GYDNC_CONFIG=./config.yml
+
+./gydnc backends --output json | sed "s#$(pwd)#<dir>#"
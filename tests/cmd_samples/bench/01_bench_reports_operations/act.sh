@@ -0,0 +1,20 @@
+#!/bin/bash
+set -euo pipefail
+
+cat > config.yml <<EOF2
+default_backend: main
+storage_backends:
+  main:
+    type: localfs
+    localfs:
+      path: store
+EOF2
+mkdir -p store
+export GYDNC_CONFIG=./config.yml
+
+./gydnc create one --title "One" 2>/dev/null
+./gydnc create two --title "Two" 2>/dev/null
+
+./gydnc bench --count 5
+echo "---Remaining---"
+./gydnc list --aliases-only
@@ -0,0 +1,16 @@
+#!/bin/bash
+set -euo pipefail
+
+cat > config.yml <<EOF2
+default_backend: main
+storage_backends:
+  main:
+    type: localfs
+    localfs:
+      path: store
+EOF2
+mkdir -p store
+export GYDNC_CONFIG=./config.yml
+
+# With no entities the get benchmark is skipped with a warning on stderr; timings are masked
+./gydnc bench --count 2 --no-write --output json | sed -E 's/: [0-9][0-9.e+-]*/: 0/'
@@ -0,0 +1,21 @@
+#!/bin/bash
+set -euo pipefail
+
+cat > config.yml <<EOF2
+default_backend: main
+storage_backends:
+  main:
+    type: localfs
+    localfs:
+      path: store
+      git_autocommit: true
+EOF2
+mkdir -p store
+export GYDNC_CONFIG=./config.yml
+
+./gydnc create one --title "One" 2>/dev/null
+
+# Writes would be committed to git, so only the read benchmarks run
+./gydnc bench --count 2 2>&1 | grep -v '^OPERATION' | sed -E 's/^([a-z]+) .*/\1/'
+echo "---Remaining---"
+./gydnc list --aliases-only
@@ -0,0 +1,18 @@
+#!/bin/bash
+set -e
+
+unset GYDNC_CONFIG
+# Stop config discovery at this directory
+export HOME="$(pwd)"
+
+echo "---No config---"
+./gydnc config path 2>/dev/null || echo "exit=$?"
+
+./gydnc init >/dev/null 2>&1
+mkdir -p sub/dir
+echo "---Discovered---"
+(cd sub/dir && ../../gydnc config path) | sed "s#$(pwd)#<dir>#"
+echo "---Env---"
+GYDNC_CONFIG=other.yml ./gydnc config path | sed "s#$(pwd)#<dir>#"
+echo "---Flag---"
+GYDNC_CONFIG=other.yml ./gydnc config path --config flag.yml | sed "s#$(pwd)#<dir>#"
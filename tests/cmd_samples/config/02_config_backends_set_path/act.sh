@@ -0,0 +1,14 @@
+#!/bin/bash
+set -e
+
+./gydnc init >/dev/null 2>&1
+export GYDNC_CONFIG=./.gydnc/config.yml
+
+./gydnc create alpha --body "a" >/dev/null 2>&1 </dev/null || { echo 'create failed'; exit 1; }
+mkdir moved && mv .gydnc/alpha.g6e moved/
+
+./gydnc config backends set-path default_local ../moved
+grep 'path:' .gydnc/config.yml | tr -s ' '
+./gydnc list --aliases-only
+echo "---Errors---"
+./gydnc config backends set-path nope ../x 2>&1 || echo "exit: $?"
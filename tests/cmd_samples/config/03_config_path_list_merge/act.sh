@@ -0,0 +1,37 @@
+#!/bin/bash
+set -euo pipefail
+
+# Shared base config with one backend, and a project overlay that adds a backend
+# and switches the default to it
+mkdir -p base project
+cat > base/config.yml <<'YAML'
+default_backend: shared
+storage_backends:
+  shared:
+    type: localfs
+    localfs:
+      path: shared_data
+YAML
+cat > project/config.yml <<'YAML'
+default_backend: project
+storage_backends:
+  project:
+    type: localfs
+    localfs:
+      path: project_data
+YAML
+
+export GYDNC_CONFIG=./base/config.yml
+echo "Shared body" | ./gydnc create shared-entity --title "Shared" >/dev/null 2>&1
+
+export GYDNC_CONFIG=./base/config.yml:./project/config.yml
+echo "Project body" | ./gydnc create project-entity --title "Project" >/dev/null 2>&1
+
+echo "=== Backends from both files ==="
+./gydnc backends --output json | grep -E '"(name|default)"'
+
+echo "=== Entities from both backends ==="
+./gydnc list --output json | grep '"alias"'
+
+echo "=== New entity went to the overlay's default backend ==="
+ls project/project_data
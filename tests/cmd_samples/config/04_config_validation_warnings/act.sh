@@ -0,0 +1,21 @@
+#!/bin/bash
+set -euo pipefail
+
+# default_backend names a backend that is not defined, and one backend has an empty path
+cat > config.yml <<'YAML'
+default_backend: main
+storage_backends:
+  mian:
+    type: localfs
+    localfs:
+      path: ./main
+  scratch:
+    type: localfs
+    localfs:
+      path: ""
+YAML
+mkdir -p main
+export GYDNC_CONFIG=./config.yml
+
+echo "=== Validation problems reported on load ==="
+./gydnc list 2>&1 >/dev/null | grep 'Invalid configuration' || true
@@ -0,0 +1,36 @@
+#!/bin/bash
+set -uo pipefail
+
+echo "=== Valid config ==="
+./gydnc init >/dev/null 2>&1
+echo "Body" | ./gydnc create sample --title "Sample" >/dev/null 2>&1
+GYDNC_CONFIG=./.gydnc/config.yml ./gydnc config validate
+echo "exit: $?"
+
+echo "=== Config with problems ==="
+touch not_a_dir
+mkdir -p empty_data
+cat > config.yml <<'YAML'
+default_backend: main
+storage_backends:
+  broken:
+    type: localfs
+    localfs:
+      path: not_a_dir/store
+  empty:
+    type: localfs
+    localfs:
+      path: empty_data
+  remote:
+    type: s3
+YAML
+GYDNC_CONFIG=./config.yml ./gydnc config validate 2>/dev/null
+echo "exit: $?"
+
+echo "=== Unparseable config ==="
+printf 'default_backend: main\nstorage_backends: [oops\n' > broken.yml
+GYDNC_CONFIG=./broken.yml ./gydnc config validate 2>&1
+echo "exit: $?"
+echo "=== Other commands show the reason ==="
+GYDNC_CONFIG=./broken.yml ./gydnc list 2>&1
+echo "exit: $?"
@@ -0,0 +1,17 @@
+#!/bin/bash
+set -euo pipefail
+
+./gydnc init > /dev/null 2>&1
+export GYDNC_CONFIG="$(pwd)/.gydnc/config.yml"
+
+# A hand-formatted file, so the backup must keep the stored bytes rather than re-serialize
+printf -- '---\ntitle: Keep Me\ntags: [b, a]\n---\nBody with trailing space.  \n' > .gydnc/keep-me.g6e
+mkdir -p .gydnc/team && cp .gydnc/keep-me.g6e .gydnc/team/rule.g6e
+
+echo "---Delete---"
+./gydnc delete keep-me team/rule -f --keep-backup backups 2>/dev/null
+echo "---Backups---"
+find backups -type f | sort
+cmp backups/default_local/keep-me.g6e <(printf -- '---\ntitle: Keep Me\ntags: [b, a]\n---\nBody with trailing space.  \n') && echo "exact copy"
+echo "---Deleted---"
+./gydnc list --count
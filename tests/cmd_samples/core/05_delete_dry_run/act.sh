@@ -0,0 +1,16 @@
+#!/bin/bash
+set -euo pipefail
+
+./gydnc init > /dev/null 2>&1
+export GYDNC_CONFIG="$(pwd)/.gydnc/config.yml"
+
+./gydnc create alpha --title "Alpha" --body "a" >/dev/null 2>&1 </dev/null
+./gydnc create beta --body "b" >/dev/null 2>&1 </dev/null
+
+echo "---Dry run---"
+./gydnc delete alpha beta --dry-run 2>/dev/null | sed "s#$(pwd)#<dir>#"
+echo "---Still there---"
+./gydnc list --aliases-only
+echo "---With force---"
+./gydnc delete alpha --dry-run --force 2>/dev/null | sed "s#$(pwd)#<dir>#"
+./gydnc list --count
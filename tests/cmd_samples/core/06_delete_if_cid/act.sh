@@ -0,0 +1,32 @@
+#!/bin/bash
+set -e
+
+cat > config.yml <<EOF2
+default_backend: one
+storage_backends:
+  one:
+    type: localfs
+    localfs:
+      path: one_data
+  two:
+    type: localfs
+    localfs:
+      path: two_data
+EOF2
+mkdir -p one_data two_data
+export GYDNC_CONFIG=./config.yml
+
+./gydnc create alpha --backend one --body "original" >/dev/null 2>&1 </dev/null
+./gydnc create alpha --backend two --body "original" >/dev/null 2>&1 </dev/null
+cid=$(./gydnc hash alpha 2>/dev/null | cut -d' ' -f2)
+
+echo "original body" | ./gydnc update alpha --backend one >/dev/null 2>&1
+
+echo "---Stale CID---"
+./gydnc delete alpha --backend one --if-cid "$cid" --force 2>&1 | grep -v '^level=' | sed "s#$cid#<old>#; s#CID [0-9a-f]\{64\}#CID <new>#" || true
+echo "---Matching CID in two---"
+./gydnc delete alpha --backend two --if-cid "$cid" --force 2>/dev/null
+echo "---Remaining---"
+./gydnc list --backends union --jsonl 2>/dev/null
+echo "---Unknown backend---"
+./gydnc delete alpha --backend three --force 2>&1 | grep -v '^level=' || true
@@ -0,0 +1,22 @@
+#!/bin/bash
+set -u
+
+./gydnc init >/dev/null 2>&1
+export GYDNC_CONFIG=./.gydnc/config.yml
+
+echo "Body" | ./gydnc create rule --title "Rule" >/dev/null 2>&1
+
+echo "=== Entity already exists ==="
+echo "Body" | ./gydnc --json-errors create rule --title "Rule" 2>&1 >/dev/null | grep '^{'
+echo "exit: ${PIPESTATUS[1]}"
+
+echo "=== Entity not found ==="
+./gydnc --json-errors update missing --title "X" </dev/null 2>&1 >/dev/null | grep '^{'
+echo "exit: ${PIPESTATUS[0]}"
+
+echo "=== Unknown backend ==="
+./gydnc --json-errors list --backend nope 2>&1 >/dev/null | grep '^{'
+echo "exit: ${PIPESTATUS[0]}"
+
+echo "=== Plain errors without the flag ==="
+./gydnc update missing --title "X" </dev/null 2>&1 >/dev/null | grep '^failed'
@@ -0,0 +1,15 @@
+#!/bin/bash
+set -euo pipefail
+
+./gydnc init > /dev/null 2>&1
+export GYDNC_CONFIG="$(pwd)/.gydnc/config.yml"
+
+# Frontmatter that cannot be parsed; deleting it is the usual way to get rid of it
+printf -- '---\ntitle: [unclosed\n---\nbody\n' > .gydnc/broken.g6e
+
+echo "---Delete---"
+./gydnc delete broken -f --keep-backup backups 2>/dev/null
+echo "---Backup---"
+cmp backups/default_local/broken.g6e <(printf -- '---\ntitle: [unclosed\n---\nbody\n') && echo "exact copy"
+echo "---Deleted---"
+test -e .gydnc/broken.g6e && echo "still there" || echo "gone"
@@ -0,0 +1,19 @@
+#!/bin/bash
+set -uo pipefail
+
+./gydnc init > /dev/null 2>&1
+export GYDNC_CONFIG="$(pwd)/.gydnc/config.yml"
+
+./gydnc create alpha --body "a" >/dev/null 2>&1 </dev/null
+./gydnc create beta --body "b" >/dev/null 2>&1 </dev/null
+
+echo "---All missing---"
+./gydnc delete missing other -f 2>&1 | grep -v '^level='
+echo "exit=${PIPESTATUS[0]}"
+echo "---Backup fails---"
+# A file where the backend directory of the backup should go makes every backup fail
+mkdir -p backups && touch backups/default_local
+./gydnc delete alpha -f --keep-backup backups 2>&1 | grep -v '^level=' | sed 's/: .*/: <reason>/'
+echo "exit=${PIPESTATUS[0]}"
+echo "---Remaining---"
+./gydnc list --aliases-only
@@ -0,0 +1,15 @@
+#!/bin/bash
+set -e
+
+./gydnc init >/dev/null 2>&1 || { echo 'init failed'; exit 1; }
+export GYDNC_CONFIG=./.gydnc/config.yml
+
+echo "---Allowed---"
+./gydnc create ok-tags --title "OK" --tags "recipe,lang:go" --strict-tags 2>&1 && echo "created ok-tags"
+echo "---Typo---"
+./gydnc create typo-tags --title "Typo" --tags "recipe,lang:golang" --strict-tags 2>&1 || echo "exit=$?"
+echo "---Update---"
+./gydnc update ok-tags --add-tag "recipes" --strict-tags 2>&1 || echo "exit=$?"
+echo "---Missing ontology---"
+rm .gydnc/tag_ontology.md
+./gydnc create no-ontology --title "No ontology" --tags "anything" --strict-tags 2>&1 && echo "created no-ontology"
@@ -0,0 +1,10 @@
+#!/bin/bash
+set -e
+
+./gydnc init . > /dev/null 2>&1
+export GYDNC_CONFIG=.gydnc/config.yml
+
+./gydnc create regenerated --title "Old" --body "Old body" 2>/dev/null
+./gydnc create regenerated --title "New" --body "New body" --overwrite 2>/dev/null
+./gydnc create fresh --title "Fresh" --overwrite 2>/dev/null
+./gydnc get regenerated
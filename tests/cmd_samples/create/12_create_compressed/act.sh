@@ -0,0 +1,18 @@
+#!/bin/bash
+set -euo pipefail
+
+cat > config.yml <<EOF2
+default_backend: main
+storage_backends:
+  main:
+    type: localfs
+    localfs:
+      path: store
+      compress: true
+EOF2
+mkdir -p store
+export GYDNC_CONFIG=./config.yml
+
+./gydnc create zipped --title "Zipped" --body "Compressed body" 2>/dev/null
+./gydnc list --aliases-only
+./gydnc get zipped --fields body
@@ -0,0 +1,22 @@
+#!/bin/bash
+set -e
+
+cat > config.yml <<EOF2
+default_backend: main
+storage_backends:
+  main:
+    type: localfs
+    localfs:
+      path: .gydnc
+EOF2
+mkdir -p .gydnc
+export GYDNC_CONFIG=./config.yml
+
+echo "---Derived---"
+./gydnc create --alias-from-title --title "Error Handling: Don't Panic!"
+echo "---Collision---"
+./gydnc create - --alias-from-title --title "Error handling don't panic"
+echo "---Explicit alias rejected---"
+./gydnc create my-alias --alias-from-title --title "Whatever" 2>&1 || echo "exit=$?"
+echo "---Listed---"
+./gydnc list --aliases-only
@@ -0,0 +1,25 @@
+#!/bin/bash
+set -e
+
+cat > config.yml <<EOF2
+default_backend: main
+storage_backends:
+  main:
+    type: localfs
+    localfs:
+      path: .gydnc
+      git_autocommit: true
+EOF2
+mkdir -p .gydnc
+export GYDNC_CONFIG=./config.yml
+
+git -C .gydnc init -q
+git -C .gydnc config user.email "test@example.com"
+git -C .gydnc config user.name "Test"
+git -C .gydnc config commit.gpgsign false
+
+./gydnc create rule --title "Rule" --body "v1" >/dev/null 2>&1 </dev/null
+echo "v2" | ./gydnc update rule 2>/dev/null
+./gydnc delete rule --force >/dev/null 2>&1
+
+git -C .gydnc log --format=%s
@@ -0,0 +1,15 @@
+#!/bin/bash
+set -e
+
+./gydnc init >/dev/null 2>&1
+export GYDNC_CONFIG=./.gydnc/config.yml
+
+./gydnc create alpha --body "shared guidance" </dev/null 2>/dev/null
+
+echo "---Warn---"
+./gydnc create beta --body "shared guidance" </dev/null 2>&1 | grep -o 'msg="[^"]*" alias=beta existing="[^"]*"'
+echo "---Block---"
+./gydnc create gamma --body "shared guidance" --no-duplicates </dev/null 2>&1 | grep -v '^level=' || true
+echo "---Distinct---"
+./gydnc create delta --body "other guidance" --no-duplicates </dev/null 2>&1 | grep -c 'Identical content' || true
+./gydnc list --aliases-only
@@ -0,0 +1,26 @@
+#!/bin/bash
+set -e
+
+./gydnc init >/dev/null 2>&1
+export GYDNC_CONFIG=./.gydnc/config.yml
+
+mkdir -p .gydnc/templates
+cat > .gydnc/templates/rule.md <<'EOF2'
+# {{.Title}}
+
+Alias: {{.Alias}}
+Tags:{{range .Tags}} {{.}}{{end}}
+
+## Rule
+
+## Rationale
+EOF2
+
+./gydnc create style-rule --title "Style rule" --tags "scope:code,quality:clarity" --template rule </dev/null 2>/dev/null
+echo "---Templated---"
+./gydnc get style-rule --output json 2>/dev/null | grep '"body"'
+echo "---Body wins---"
+./gydnc create other-rule --title "Other" --template rule --body "explicit body" </dev/null 2>/dev/null
+./gydnc get other-rule --output json 2>/dev/null | grep '"body"'
+echo "---Missing---"
+./gydnc create missing-rule --template nope </dev/null 2>&1 | grep -v '^level=' | sed "s#$(pwd)#<dir>#" || true
@@ -0,0 +1,25 @@
+#!/bin/bash
+set -euo pipefail
+
+./gydnc init >/dev/null 2>&1
+export GYDNC_CONFIG=./.gydnc/config.yml
+
+echo "=== Edited in the editor ==="
+EDITOR="sed -i s/draft/final/" ./gydnc create edited --title "Edited" --body "draft body" --edit </dev/null 2>/dev/null
+./gydnc get edited --output raw
+
+echo "=== Editor leaves the file unchanged ==="
+EDITOR=true ./gydnc create unchanged --title "Unchanged" --body "draft body" --edit </dev/null 2>/dev/null
+./gydnc get unchanged --output raw
+
+echo "=== Editor fails ==="
+EDITOR=false ./gydnc create aborted --title "Aborted" --body "draft body" --edit </dev/null 2>&1 | grep -o 'Editor did not exit cleanly; saving entity as drafted'
+./gydnc get aborted --output raw
+
+echo "=== Edited tags are checked with --strict-tags ==="
+EDITOR="sed -i s/recipe/lang:golang/" ./gydnc create strict --title "Strict" --tags recipe --body "strict body" --strict-tags --edit </dev/null 2>&1 | grep -o "tag 'lang:golang' is not defined in the tag ontology" || true
+test -e .gydnc/strict.g6e && echo "created" || echo "not created"
+
+echo "=== Edited body is checked with --no-duplicates ==="
+EDITOR="sed -i s/draft/final/" ./gydnc create duplicate --body "draft body" --no-duplicates --edit </dev/null 2>&1 | grep -o "identical content already exists as edited (backend: default_local)" || true
+test -e .gydnc/duplicate.g6e && echo "created" || echo "not created"
@@ -0,0 +1,20 @@
+#!/bin/bash
+set -u
+
+./gydnc init >/dev/null 2>&1
+export GYDNC_CONFIG=./.gydnc/config.yml
+
+echo "=== JSON entity ==="
+echo '{"title": "No Panics", "description": "Library code returns errors", "tags": ["scope:code"], "custom_metadata": {"tier": "must"}, "body": "Return errors instead of panicking."}' \
+  | ./gydnc create no-panics --from-stdin 2>/dev/null
+./gydnc get no-panics --output raw
+
+echo "=== YAML entity; --title takes precedence ==="
+printf 'title: From YAML\ntags:\n  - scope:docs\nbody: |\n  # Docs\n\n  Keep READMEs current.\n' \
+  | ./gydnc create docs-rule --from-stdin --format yaml --title "From Flag" 2>/dev/null
+./gydnc get docs-rule --output raw
+
+echo "=== Errors ==="
+echo '{"titel": "Typo"}' | ./gydnc create typo --from-stdin 2>&1 | grep -o 'unknown field "titel"'
+echo '{"title": "X"}' | ./gydnc create both --from-stdin --body "x" 2>&1 | grep -o -- '--from-stdin cannot be combined with --body, --body-from-file or --template'
+./gydnc create plain --format yaml --body "x" 2>&1 | grep -o -- '--format requires --from-stdin'
@@ -0,0 +1,33 @@
+#!/bin/bash
+set -euo pipefail
+
+cat > config.yml <<EOF2
+default_backend: staging
+storage_backends:
+  staging:
+    type: localfs
+    localfs:
+      path: .store_staging
+  prod:
+    type: localfs
+    localfs:
+      path: .store_prod
+EOF2
+mkdir -p .store_staging .store_prod
+export GYDNC_CONFIG=./config.yml
+
+./gydnc create same --title "Same" --body "Same body" --backend staging 2>/dev/null
+./gydnc create same --title "Same" --body "Same body" --backend prod 2>/dev/null
+./gydnc create rule --title "Rule" --tags "lang:go" --body "Line one" --backend staging 2>/dev/null
+./gydnc create rule --title "Rule" --tags "lang:go" --body "Line 1" --backend prod 2>/dev/null
+
+echo "---Identical---"
+./gydnc diff same --backend staging --backend prod 2>/dev/null && echo "exit=0"
+echo "---Different---"
+./gydnc diff rule --backend staging --backend prod 2>/dev/null || echo "exit=$?"
+echo "---Missing---"
+./gydnc diff missing --backend staging --backend prod 2>/dev/null || echo "exit=$?"
+echo "---Unknown backend with --json-errors---"
+./gydnc --json-errors diff rule --backend staging --backend zz 2>&1 >/dev/null | grep -o '"code":"[a-z_]*"' || true
+echo "---Negative context---"
+./gydnc diff rule --backend staging --backend prod -U -1 2>&1 || echo "exit=$?"
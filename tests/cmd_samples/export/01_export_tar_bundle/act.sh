@@ -0,0 +1,24 @@
+#!/bin/bash
+set -e
+
+./gydnc init . >/dev/null 2>&1
+export GYDNC_CONFIG=./.gydnc/config.yml
+
+./gydnc create team/style --title "Style" --tags scope:code --body "Use gofmt" >/dev/null 2>&1 </dev/null
+./gydnc create team/review --title "Review" --tags scope:process --body "Two approvals" >/dev/null 2>&1 </dev/null
+./gydnc create intro --title "Intro" --tags scope:code --body "Hello" >/dev/null 2>&1 </dev/null
+
+./gydnc export --output bundle.tar.gz 2>/dev/null </dev/null
+./gydnc export --output again.tar.gz 2>/dev/null </dev/null
+./gydnc export --filter-tags "scope:code" > filtered.tar.gz 2>/dev/null </dev/null
+
+echo "---All---"
+tar -tzf bundle.tar.gz
+echo "---Member---"
+tar -xzOf bundle.tar.gz team/style.g6e
+echo "---Reproducible---"
+cmp bundle.tar.gz again.tar.gz && echo "identical"
+echo "---Filtered---"
+tar -tzf filtered.tar.gz
+echo "---Bad format---"
+./gydnc export --format zip --output x.tar.gz >/dev/null 2>&1 </dev/null || echo "exit=$?"
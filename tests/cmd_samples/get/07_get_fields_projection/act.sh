@@ -0,0 +1,22 @@
+#!/bin/bash
+set -e
+
+cat > config.yml <<EOF2
+default_backend: main
+storage_backends:
+  main:
+    type: localfs
+    localfs:
+      path: .gydnc
+EOF2
+mkdir -p .gydnc
+export GYDNC_CONFIG=./config.yml
+
+./gydnc create meta-only --title "Meta Only" --tags "go,test" --body "A very large body" >/dev/null 2>&1 || { echo 'create failed'; exit 1; }
+
+echo "---Title and tags---"
+./gydnc get meta-only --fields title,tags
+echo "---Empty description still shown---"
+./gydnc get meta-only --fields description
+echo "---Unknown field---"
+./gydnc get meta-only --fields title,author 2>&1 || echo "exit=$?"
@@ -0,0 +1,23 @@
+#!/bin/bash
+set -e
+
+cat > config.yml <<EOF2
+default_backend: main
+storage_backends:
+  main:
+    type: localfs
+    localfs:
+      path: .gydnc
+EOF2
+mkdir -p .gydnc
+export GYDNC_CONFIG=./config.yml
+
+./gydnc create first --title "First" --tags "go,test" --body "First body" 2>/dev/null
+./gydnc create second --title "Second" --body "Second body" 2>/dev/null
+
+echo "---YAML---"
+./gydnc get first --output yaml
+echo "---Raw---"
+./gydnc get first second --output raw
+echo "---Bad format---"
+./gydnc get first --output xml 2>&1 || echo "exit=$?"
@@ -0,0 +1,25 @@
+#!/bin/bash
+set -e
+
+cat > config.yml <<EOF2
+default_backend: main
+storage_backends:
+  main:
+    type: localfs
+    localfs:
+      path: .gydnc
+EOF2
+mkdir -p .gydnc
+export GYDNC_CONFIG=./config.yml
+
+./gydnc create stable --title "Stable" --body "Stable body" 2>/dev/null
+./gydnc create edited --title "Edited" --body "Old body" 2>/dev/null
+
+# Snapshot taken before "edited" changes; CIDs are the SHA-256 of the body
+STABLE_CID=$(printf 'Stable body\n' | sha256sum | cut -d' ' -f1)
+EDITED_CID=$(printf 'Old body\n' | sha256sum | cut -d' ' -f1)
+echo "{\"stable\": \"$STABLE_CID\", \"edited\": \"$EDITED_CID\"}" > snapshot.json
+
+echo "New body" | ./gydnc update edited 2>/dev/null
+
+./gydnc get stable edited --since snapshot.json
@@ -0,0 +1,21 @@
+#!/bin/bash
+set -e
+
+# The default backend points below a regular file, so it cannot be initialized
+touch not_a_dir
+cat > config.yml <<EOF2
+default_backend: broken
+storage_backends:
+  broken:
+    type: localfs
+    localfs:
+      path: not_a_dir/store
+  backup:
+    type: localfs
+    localfs:
+      path: backup_store
+EOF2
+mkdir -p backup_store
+export GYDNC_CONFIG=./config.yml
+
+./gydnc get wanted 2>&1 | grep -v "^Warning: could not initialize active backend"
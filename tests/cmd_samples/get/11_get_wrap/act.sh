@@ -0,0 +1,29 @@
+#!/bin/bash
+set -e
+
+cat > config.yml <<EOF2
+default_backend: main
+storage_backends:
+  main:
+    type: localfs
+    localfs:
+      path: .gydnc
+EOF2
+mkdir -p .gydnc
+export GYDNC_CONFIG=./config.yml
+
+cat > .gydnc/wrapped.g6e <<'EOF2'
+---
+title: Wrapped
+---
+Keep functions small and focused so that they are easy to read and test.
+
+```sh
+go test ./... -run TestSomethingWithAVeryLongNameThatShouldNotWrap
+```
+EOF2
+
+echo "---Raw wrapped---"
+./gydnc get wrapped --output raw --wrap 30
+echo "---Negative width---"
+./gydnc get wrapped --wrap -1 2>&1 || echo "exit=$?"
@@ -0,0 +1,22 @@
+#!/bin/bash
+set -e
+
+cat > config.yml <<EOF2
+default_backend: main
+storage_backends:
+  main:
+    type: localfs
+    localfs:
+      path: .gydnc
+EOF2
+mkdir -p .gydnc
+export GYDNC_CONFIG=./config.yml
+
+./gydnc create budget --title "Budget" --body "Keep functions small and focused." >/dev/null 2>&1 || { echo 'create failed'; exit 1; }
+
+echo "---With body---"
+./gydnc get budget --count-tokens
+echo "---Metadata only---"
+./gydnc get budget --count-tokens --fields title
+echo "---Raw---"
+./gydnc get budget --count-tokens --output raw 2>&1 || echo "exit=$?"
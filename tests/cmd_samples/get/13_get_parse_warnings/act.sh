@@ -0,0 +1,25 @@
+#!/bin/bash
+set -e
+
+cat > config.yml <<EOF2
+default_backend: main
+storage_backends:
+  main:
+    type: localfs
+    localfs:
+      path: .gydnc
+EOF2
+mkdir -p .gydnc
+export GYDNC_CONFIG=./config.yml
+
+cat > .gydnc/broken.g6e <<'EOF2'
+---
+title: [unclosed
+---
+Body survives.
+EOF2
+
+echo "---Lenient get---"
+./gydnc get broken --fields title,body 2>/dev/null
+echo "---Update refused---"
+./gydnc update broken --title "Fixed" 2>&1 | grep -o -m1 "could not be parsed cleanly" || true
@@ -0,0 +1,15 @@
+#!/bin/bash
+set -e
+
+./gydnc init >/dev/null 2>&1 || { echo 'init failed'; exit 1; }
+export GYDNC_CONFIG=./.gydnc/config.yml
+
+# copy-a and copy-b share a body, so they share a content ID
+./gydnc create copy-a --title "Copy A" --body "Shared rule" >/dev/null 2>&1 </dev/null
+./gydnc create copy-b --title "Copy B" --body "Shared rule" >/dev/null 2>&1 </dev/null
+./gydnc create unique --title "Unique" --body "Other rule" >/dev/null 2>&1 </dev/null
+
+echo "---Deduped---"
+./gydnc get --dedupe-by-cid copy-a unique copy-b --fields title
+echo "---Raw---"
+./gydnc get --dedupe-by-cid --output raw copy-a copy-b
@@ -0,0 +1,27 @@
+#!/bin/bash
+set -e
+
+cat > config.yml <<EOF2
+default_backend: tidy
+storage_backends:
+  tidy:
+    type: localfs
+    localfs:
+      path: ./tidy
+  messy:
+    type: localfs
+    localfs:
+      path: ./messy
+EOF2
+mkdir -p tidy messy
+export GYDNC_CONFIG=./config.yml
+
+printf -- '---\ntitle: Rule\ntags:\n    - a\n    - b\n---\nKeep it short.\n' > tidy/rule.g6e
+printf -- '---\ntitle: Rule\ntags: [b, a]\n---\nKeep it short.   \n\n\n' > messy/other.g6e
+
+echo "---Normalized---"
+./gydnc get other --output g6e --normalize
+echo "---Same canonical form---"
+diff <(./gydnc get rule --output g6e --normalize) <(./gydnc get other --output g6e --normalize) && echo "identical"
+echo "---Normalized CID matches---"
+./gydnc get rule other --normalize --extended --dedupe-by-cid --fields title
@@ -0,0 +1,20 @@
+#!/bin/bash
+set -e
+
+./gydnc init >/dev/null 2>&1
+export GYDNC_CONFIG=./.gydnc/config.yml
+
+cat > .gydnc/alpha.g6e <<'G6E'
+---
+title: Alpha
+tags:
+    - scope:code
+tier: must
+---
+alpha body
+G6E
+
+cid=$(printf 'alpha body\n' | sha256sum | cut -d' ' -f1)
+./gydnc get alpha --output g6e --annotate-source | sed "s/$cid/<cid>/"
+echo "---"
+./gydnc get alpha --annotate-source 2>&1 || echo "exit: $?"
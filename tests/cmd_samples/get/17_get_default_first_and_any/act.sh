@@ -0,0 +1,27 @@
+#!/bin/bash
+set -e
+
+cat > config.yml <<EOF2
+default_backend: zeta
+storage_backends:
+  alpha:
+    type: localfs
+    localfs:
+      path: alpha_data
+  zeta:
+    type: localfs
+    localfs:
+      path: zeta_data
+EOF2
+mkdir -p alpha_data zeta_data
+export GYDNC_CONFIG=./config.yml
+
+./gydnc create shared --backend alpha --title "From alpha" --body "a" >/dev/null 2>&1 </dev/null || { echo 'create failed'; exit 1; }
+./gydnc create shared --backend zeta --title "From zeta" --body "z" >/dev/null 2>&1 </dev/null || { echo 'create failed'; exit 1; }
+./gydnc create alpha-only --backend alpha --title "Alpha only" --body "o" >/dev/null 2>&1 </dev/null || { echo 'create failed'; exit 1; }
+
+echo "---Default first---"
+for i in 1 2 3; do ./gydnc get shared | grep '"title"'; done
+echo "---Any---"
+./gydnc get shared --any | grep -c -E '"title": "From (alpha|zeta)"'
+./gydnc get alpha-only --any | grep '"title"'
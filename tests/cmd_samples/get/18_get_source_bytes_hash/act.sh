@@ -0,0 +1,17 @@
+#!/bin/bash
+set -e
+
+./gydnc init >/dev/null 2>&1
+export GYDNC_CONFIG=./.gydnc/config.yml
+
+./gydnc create clean --title "Clean" --tags "a,b" --body "clean body" >/dev/null 2>&1 </dev/null || { echo 'create failed'; exit 1; }
+printf -- '---\ntitle: Messy\ntags: [b, a]\n---\nbody with trailing space  \n\n\n' > .gydnc/messy.g6e
+
+clean_hash=$(sha256sum < .gydnc/clean.g6e | cut -d' ' -f1)
+messy_hash=$(sha256sum < .gydnc/messy.g6e | cut -d' ' -f1)
+messy_canonical=$(./gydnc get messy --output g6e --normalize | sha256sum | cut -d' ' -f1)
+
+./gydnc get clean messy --include-source-bytes-hash --fields title \
+  | sed "s/$clean_hash/<clean-stored>/g; s/$messy_hash/<messy-stored>/; s/$messy_canonical/<messy-canonical>/"
+echo "---Raw---"
+./gydnc get clean --output raw --include-source-bytes-hash 2>&1 || echo "exit: $?"
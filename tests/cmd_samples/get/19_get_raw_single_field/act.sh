@@ -0,0 +1,16 @@
+#!/bin/bash
+set -e
+
+./gydnc init >/dev/null 2>&1
+export GYDNC_CONFIG=./.gydnc/config.yml
+
+printf 'Line one\nLine two\n' | ./gydnc create alpha --title "Alpha" --tags "scope:code,quality:clarity" 2>/dev/null
+
+echo "---Body---"
+./gydnc get alpha --fields body --output raw 2>/dev/null
+echo "---Title---"
+./gydnc get alpha --fields title --output raw 2>/dev/null
+echo "---Tags---"
+./gydnc get alpha --fields tags --output raw 2>/dev/null
+echo "---Two fields---"
+./gydnc get alpha --fields title,body --output raw 2>&1 | grep -v '^level=' || true
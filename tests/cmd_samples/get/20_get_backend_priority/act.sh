@@ -0,0 +1,42 @@
+#!/bin/bash
+set -e
+
+write_config() {
+cat > config.yml <<EOF2
+default_backend: one
+$1
+storage_backends:
+  one:
+    type: localfs
+    localfs:
+      path: one_data
+  two:
+    type: localfs
+    localfs:
+      path: two_data
+  three:
+    type: localfs
+    localfs:
+      path: three_data
+EOF2
+}
+write_config ""
+mkdir -p one_data two_data three_data
+export GYDNC_CONFIG=./config.yml
+
+for b in one two three; do
+  ./gydnc create shared --backend $b --title "Shared $b" --body "$b" >/dev/null 2>&1 </dev/null || { echo 'create failed'; exit 1; }
+done
+./gydnc create extra --backend two --title "Extra two" --body "x2" >/dev/null 2>&1 </dev/null
+./gydnc create extra --backend three --title "Extra three" --body "x3" >/dev/null 2>&1 </dev/null
+
+echo "---Default first---"
+./gydnc get shared --fields title --output raw 2>/dev/null
+./gydnc get extra --fields title --output raw 2>/dev/null
+./gydnc list --jsonl 2>/dev/null
+
+write_config "backend_priority: [two]"
+echo "---Priority---"
+./gydnc get shared --fields title --output raw 2>/dev/null
+./gydnc get extra --fields title --output raw 2>/dev/null
+./gydnc list --jsonl 2>/dev/null
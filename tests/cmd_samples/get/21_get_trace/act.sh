@@ -0,0 +1,27 @@
+#!/bin/bash
+set -e
+
+cat > config.yml <<EOF2
+default_backend: one
+storage_backends:
+  one:
+    type: localfs
+    localfs:
+      path: one_data
+  two:
+    type: localfs
+    localfs:
+      path: two_data
+EOF2
+mkdir -p one_data two_data
+export GYDNC_CONFIG=./config.yml
+
+./gydnc create local-rule --backend one --body "a" >/dev/null 2>&1 </dev/null
+./gydnc create team-rule --backend two --body "b" >/dev/null 2>&1 </dev/null
+
+echo "---Trace---"
+./gydnc get local-rule team-rule missing --trace --fields title 2>&1 >/dev/null | grep '^trace:' | sed -E 's/(duration|total)=[^ ]+/\1=<t>/'
+echo "---Stdout unchanged---"
+./gydnc get team-rule --trace --fields title --output raw 2>/dev/null | wc -l | tr -d ' '
+echo "---With any---"
+./gydnc get team-rule --trace --any 2>&1 | grep -v '^level=' || true
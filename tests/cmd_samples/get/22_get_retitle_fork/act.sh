@@ -0,0 +1,14 @@
+#!/bin/bash
+set -euo pipefail
+
+./gydnc init >/dev/null 2>&1
+export GYDNC_CONFIG=./.gydnc/config.yml
+
+echo "Always write tests." | ./gydnc create rule --title "Rule" --tags scope:code,quality:tests >/dev/null 2>&1
+
+echo "=== Forked copy with overridden title and tags ==="
+./gydnc get rule --retitle "Rule (strict)" --retag scope:code,strict --output g6e | tee .gydnc/rule-strict.g6e
+
+echo "=== Original is unchanged; fork is listed ==="
+./gydnc list --output json | grep -E '"(alias|title)"'
+./gydnc get rule --fields tags --output raw
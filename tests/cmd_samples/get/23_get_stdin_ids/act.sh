@@ -0,0 +1,17 @@
+#!/bin/bash
+set -u
+
+./gydnc init >/dev/null 2>&1
+export GYDNC_CONFIG=./.gydnc/config.yml
+
+echo "Body A" | ./gydnc create alpha --title "Alpha" >/dev/null 2>&1
+echo "Body B" | ./gydnc create beta --title "Beta" >/dev/null 2>&1
+
+echo "=== Piped from list ==="
+./gydnc list --aliases-only | ./gydnc get - --fields title 2>/dev/null
+
+echo "=== Missing IDs keep their placeholders ==="
+printf 'alpha\n\nmissing\n' | ./gydnc get - --fields title 2>/dev/null
+
+echo "=== Empty stdin ==="
+printf '\n' | ./gydnc get - 2>&1 | grep -o 'no IDs read from stdin'
@@ -0,0 +1,33 @@
+#!/bin/bash
+set -u
+
+cat > config.yml <<'YAML'
+default_backend: local
+storage_backends:
+  local:
+    type: localfs
+    localfs:
+      path: ./local_data
+  prod:
+    type: localfs
+    localfs:
+      path: ./prod_data
+YAML
+mkdir -p local_data prod_data
+export GYDNC_CONFIG=./config.yml
+
+./gydnc create rule --title "Local Rule" --body "local" --backend local >/dev/null 2>&1 </dev/null
+./gydnc create rule --title "Prod Rule" --body "prod" --backend prod >/dev/null 2>&1 </dev/null
+./gydnc create only-local --title "Only Local" --body "x" --backend local >/dev/null 2>&1 </dev/null
+
+echo "=== Unqualified uses priority order ==="
+./gydnc get rule --fields title 2>/dev/null
+
+echo "=== Qualified reads the named backend ==="
+./gydnc get prod:rule local:rule --fields title 2>/dev/null
+
+echo "=== Qualified alias missing from that backend ==="
+./gydnc get prod:only-local 2>&1 | grep -o 'failed to read entity only-local from backend prod'
+
+echo "=== Unknown prefix is resolved as a plain alias ==="
+./gydnc get staging:rule 2>&1 | grep -o 'entity staging:rule not found in any available backend'
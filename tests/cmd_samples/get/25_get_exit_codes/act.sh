@@ -0,0 +1,23 @@
+#!/bin/bash
+set -u
+
+./gydnc init >/dev/null 2>&1
+export GYDNC_CONFIG=./.gydnc/config.yml
+
+echo "Body A" | ./gydnc create alpha --title "Alpha" >/dev/null 2>&1
+
+echo "=== Single missing ID ==="
+./gydnc get nope >/dev/null 2>&1
+echo "exit: $?"
+
+echo "=== Single missing ID with --json-errors ==="
+./gydnc --json-errors get nope 2>&1 >/dev/null | grep '^{'
+echo "exit: ${PIPESTATUS[0]}"
+
+echo "=== Several IDs with one missing ==="
+./gydnc get alpha nope --fields title 2>/dev/null
+echo "exit: $?"
+
+echo "=== Several IDs all found ==="
+./gydnc get alpha alpha --fields title >/dev/null 2>&1
+echo "exit: $?"
@@ -0,0 +1,26 @@
+#!/bin/bash
+set -e
+
+./gydnc init >/dev/null 2>&1
+export GYDNC_CONFIG=./.gydnc/config.yml
+
+./gydnc create beta --tags "scope:docs" --body "beta body" >/dev/null 2>&1 </dev/null || { echo 'create failed'; exit 1; }
+./gydnc create alpha --tags "scope:code" --body "alpha body" >/dev/null 2>&1 </dev/null || { echo 'create failed'; exit 1; }
+
+alpha=$(printf 'alpha body\n' | sha256sum | cut -d' ' -f1)
+beta=$(printf 'beta body\n' | sha256sum | cut -d' ' -f1)
+mask() { sed "s/$alpha/<alpha-cid>/; s/$beta/<beta-cid>/"; }
+
+echo "---All---"
+./gydnc hash --all | mask
+echo "---Filtered---"
+./gydnc hash --all --filter-tags "scope:code" | mask
+echo "---Alias---"
+./gydnc hash beta | mask
+echo "---JSON---"
+./gydnc hash --all --output json | tee snapshot.json | mask
+echo "---Since---"
+./gydnc get alpha beta --since snapshot.json | grep -c '"unchanged": true'
+echo "---Errors---"
+./gydnc hash 2>&1 || echo "exit: $?"
+./gydnc hash alpha --all 2>&1 || echo "exit: $?"
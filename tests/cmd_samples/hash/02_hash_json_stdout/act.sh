@@ -0,0 +1,10 @@
+#!/bin/bash
+
+./gydnc init >/dev/null 2>&1
+export GYDNC_CONFIG=./.gydnc/config.yml
+
+./gydnc create alpha --body "alpha body" >/dev/null 2>&1 </dev/null || { echo 'create failed'; exit 1; }
+alpha=$(printf 'alpha body\n' | sha256sum | cut -d' ' -f1)
+
+# The missing alias is reported on stderr; stdout is still the JSON manifest of the rest
+./gydnc hash alpha missing --output json | sed "s/$alpha/<alpha-cid>/"
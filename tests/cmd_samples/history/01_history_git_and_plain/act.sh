@@ -0,0 +1,37 @@
+#!/bin/bash
+set -e
+
+cat > config.yml <<EOF2
+default_backend: tracked
+storage_backends:
+  tracked:
+    type: localfs
+    localfs:
+      path: ./tracked
+  plain:
+    type: localfs
+    localfs:
+      path: ./plain
+EOF2
+mkdir -p tracked plain
+export GYDNC_CONFIG=./config.yml
+
+# Only the tracked backend directory is a git repository
+git -C tracked init -q
+git -C tracked config user.email "test@example.com"
+git -C tracked config user.name "Test"
+git -C tracked config commit.gpgsign false
+
+./gydnc create rule --title "Rule" --body "v1" --backend tracked >/dev/null 2>&1 </dev/null
+git -C tracked add rule.g6e && git -C tracked commit -q -m "Add rule"
+echo "v2" | ./gydnc update rule 2>/dev/null
+git -C tracked commit -q -am "Reword rule"
+
+./gydnc create loose --title "Loose" --backend plain >/dev/null 2>&1 </dev/null
+
+echo "---Tracked---"
+./gydnc history rule | sed -E 's/^[0-9a-f]+ /<hash> /'
+echo "---Untracked---"
+./gydnc history loose
+echo "---Missing---"
+./gydnc history nope >/dev/null 2>&1 || echo "exit=$?"
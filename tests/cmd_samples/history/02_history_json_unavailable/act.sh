@@ -0,0 +1,14 @@
+#!/bin/bash
+set -e
+
+./gydnc init >/dev/null 2>&1
+export GYDNC_CONFIG=./.gydnc/config.yml
+
+./gydnc create loose --title "Loose" >/dev/null 2>&1 </dev/null
+
+echo "---Text---"
+./gydnc history loose 2>/dev/null
+echo "---Json stdout---"
+./gydnc history loose --output json 2>/dev/null
+echo "---Json stderr---"
+./gydnc history loose --output json 2>&1 >/dev/null | grep -o 'msg="[^"]*" reason="[^"]*"'
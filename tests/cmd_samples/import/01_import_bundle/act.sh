@@ -0,0 +1,35 @@
+#!/bin/bash
+set -e
+
+cat > config.yml <<EOF2
+default_backend: source
+storage_backends:
+  source:
+    type: localfs
+    localfs:
+      path: ./source
+  dest:
+    type: localfs
+    localfs:
+      path: ./dest
+EOF2
+mkdir -p source dest
+export GYDNC_CONFIG=./config.yml
+
+./gydnc create team/style --title "Style" --tags scope:code --body "Use gofmt" >/dev/null 2>&1 </dev/null
+./gydnc create intro --title "Intro" --body "Hello" >/dev/null 2>&1 </dev/null
+./gydnc export --output bundle.tar.gz 2>/dev/null </dev/null
+
+echo "---Import---"
+./gydnc import bundle.tar.gz --backend dest 2>/dev/null
+cat dest/team/style.g6e
+echo "---Reimport fails by default---"
+./gydnc import bundle.tar.gz --backend dest 2>/dev/null || echo "exit=$?"
+echo "---Skip---"
+./gydnc import bundle.tar.gz --backend dest --on-conflict skip 2>/dev/null
+echo "---Overwrite---"
+./gydnc import bundle.tar.gz --backend dest --on-conflict overwrite 2>/dev/null
+echo "---Invalid member---"
+mkdir -p bad && printf -- '---\ntitle: [unclosed\n---\nbody\n' > bad/broken.g6e
+tar -czf bad.tar.gz -C bad broken.g6e
+./gydnc import bad.tar.gz --backend dest 2>/dev/null || echo "exit=$?"
@@ -0,0 +1,11 @@
+#!/bin/bash
+set -e
+
+./gydnc init >/dev/null 2>&1
+
+echo "---Nested without --force---"
+./gydnc init project/docs 2>&1 >/dev/null | grep -v '^level=INFO' | sed "s#$(pwd)#<dir>#g" || true
+test -d project/docs/.gydnc && echo "created" || echo "not created"
+echo "---Nested with --force---"
+./gydnc init --force project/docs >/dev/null 2>&1
+test -f project/docs/.gydnc/config.yml && echo "created"
@@ -0,0 +1,12 @@
+#!/bin/bash
+set -euo pipefail
+
+./gydnc init --gitignore >/dev/null 2>&1
+export GYDNC_CONFIG=./.gydnc/config.yml
+
+echo "=== Starter .gitignore patterns ==="
+grep -v '^#' .gydnc/.gitignore | grep -v '^$'
+
+echo "=== Entity in a store with a .gitignore ==="
+echo "Body" | ./gydnc create sample --title "Sample" >/dev/null 2>&1
+./gydnc list --output json
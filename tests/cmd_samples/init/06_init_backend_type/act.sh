@@ -0,0 +1,14 @@
+#!/bin/bash
+set -uo pipefail
+
+echo "=== Unknown backend type ==="
+./gydnc init --backend-type s3 2>&1 | grep -o "unsupported backend type.*"
+[ -d .gydnc ] || echo "no store created"
+
+echo "=== inmem store ==="
+./gydnc init --backend-type inmem >/dev/null 2>&1
+export GYDNC_CONFIG=./.gydnc/config.yml
+grep -A3 'default_local:' .gydnc/config.yml
+printf -- '---\ntitle: Seeded\n---\nSeeded body\n' > .gydnc/seeded.g6e
+./gydnc list --aliases-only
+./gydnc config validate
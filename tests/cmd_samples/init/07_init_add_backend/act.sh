@@ -0,0 +1,14 @@
+#!/bin/bash
+set -uo pipefail
+
+./gydnc init >/dev/null 2>&1
+export GYDNC_CONFIG=./.gydnc/config.yml
+
+echo "=== Add a backend to the existing store ==="
+./gydnc init --add-backend staging --path ./staging_data 2>/dev/null | sed "s#$(pwd)#<dir>#"
+./gydnc backends --output json | grep -E '"(name|default)"'
+
+echo "=== Existing name is refused without --force ==="
+./gydnc init --add-backend staging --path ./other_data 2>&1 | grep -o "backend 'staging' is already configured.*" | sed "s#$(pwd)#<dir>#"
+./gydnc init --add-backend staging --path ./other_data --force 2>/dev/null | sed "s#$(pwd)#<dir>#"
+grep -A3 'staging:' .gydnc/config.yml | grep 'path:' | sed "s#$(pwd)#<dir>#" | tr -s ' '
@@ -0,0 +1,18 @@
+#!/bin/bash
+set -u
+
+./gydnc init >/dev/null 2>&1
+export GYDNC_CONFIG=./.gydnc/config.yml
+
+echo "=== Without --set-default the default is kept ==="
+./gydnc init --add-backend staging --path ./staging_data >/dev/null 2>&1
+grep 'default_backend:' .gydnc/config.yml
+
+echo "=== With --set-default the added backend becomes the default ==="
+./gydnc init --add-backend prod --path ./prod_data --set-default 2>/dev/null | sed "s#$(pwd)#<dir>#"
+grep 'default_backend:' .gydnc/config.yml
+echo "Body" | ./gydnc create sample --title "Sample" >/dev/null 2>&1
+ls prod_data
+
+echo "=== --set-default needs --add-backend ==="
+./gydnc init --set-default 2>&1 | grep -o -- "--set-default requires --add-backend"
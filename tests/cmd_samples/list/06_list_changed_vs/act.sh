@@ -0,0 +1,22 @@
+#!/bin/bash
+set -euo pipefail
+
+TEST_DIR=$(pwd)
+CONFIG_CONTENT="default_backend: staging\nstorage_backends:\n  staging:\n    type: localfs\n    localfs:\n      path: $TEST_DIR/staging_data\n  prod:\n    type: localfs\n    localfs:\n      path: $TEST_DIR/prod_data\n"
+mkdir -p .gydnc staging_data prod_data
+echo -e "$CONFIG_CONTENT" > .gydnc/config.yml
+export GYDNC_CONFIG="$TEST_DIR/.gydnc/config.yml"
+
+# Identical in both backends
+./gydnc create same --title "Same" --body "Same body" --backend staging > /dev/null 2>&1
+./gydnc create same --title "Same" --body "Same body" --backend prod > /dev/null 2>&1
+# Body differs between backends
+./gydnc create changed --title "Changed" --body "New body" --backend staging > /dev/null 2>&1
+./gydnc create changed --title "Changed" --body "Old body" --backend prod > /dev/null 2>&1
+# Only in one backend
+./gydnc create new/entity --title "New" --backend staging > /dev/null 2>&1
+./gydnc create obsolete --title "Obsolete" --backend prod > /dev/null 2>&1
+
+./gydnc list --changed-vs prod --backend staging
+echo "---Default backend vs prod---"
+./gydnc list --changed-vs prod
@@ -0,0 +1,13 @@
+#!/bin/bash
+set -euo pipefail
+
+TEST_DIR=$(pwd)
+CONFIG_CONTENT="default_backend: primary\nstorage_backends:\n  primary:\n    type: localfs\n    localfs:\n      path: $TEST_DIR/test_data\n"
+mkdir -p .gydnc test_data
+echo -e "$CONFIG_CONTENT" > .gydnc/config.yml
+export GYDNC_CONFIG="$TEST_DIR/.gydnc/config.yml"
+
+./gydnc create entityA --title "Entity A" --tags "scope:code,feature:new" 2>/dev/null
+./gydnc create entityC --title "Entity C" --tags "scope:code,status:deprecated" 2>/dev/null
+
+./gydnc list --filter-tags "scope:* NOT status:deprecated" --explain
@@ -0,0 +1,20 @@
+#!/bin/bash
+set -euo pipefail
+
+cat > config.yml <<EOF2
+default_backend: main
+storage_backends:
+  main:
+    type: localfs
+    localfs:
+      path: store
+EOF2
+mkdir -p store
+export GYDNC_CONFIG=./config.yml
+
+./gydnc create scope/rule --title "Rule" 2>/dev/null
+
+echo "---Without paths---"
+./gydnc list
+echo "---With paths---"
+./gydnc list --with-paths | sed "s|$(pwd)|<TESTDIR>|"
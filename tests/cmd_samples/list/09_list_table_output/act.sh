@@ -0,0 +1,20 @@
+#!/bin/bash
+set -euo pipefail
+
+cat > config.yml <<EOF2
+default_backend: main
+storage_backends:
+  main:
+    type: localfs
+    localfs:
+      path: store
+EOF2
+mkdir -p store
+export GYDNC_CONFIG=./config.yml
+
+./gydnc create zeta --title "Zeta entry" --tags "lang:go,recipe" 2>/dev/null
+./gydnc create alpha --title "Alpha" 2>/dev/null
+
+./gydnc list --output table
+echo "---No header---"
+./gydnc list --output table --no-header
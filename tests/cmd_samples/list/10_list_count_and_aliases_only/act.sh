@@ -0,0 +1,26 @@
+#!/bin/bash
+set -euo pipefail
+
+cat > config.yml <<EOF2
+default_backend: main
+storage_backends:
+  main:
+    type: localfs
+    localfs:
+      path: store
+EOF2
+mkdir -p store
+export GYDNC_CONFIG=./config.yml
+
+./gydnc create b-rule --title "B" --tags "lang:go" 2>/dev/null
+./gydnc create a-rule --title "A" --tags "lang:go" 2>/dev/null
+./gydnc create other --title "Other" --tags "lang:python" 2>/dev/null
+
+echo "---Count---"
+./gydnc list --count
+echo "---Filtered count---"
+./gydnc list --count --filter-tags "lang:go"
+echo "---Aliases---"
+./gydnc list --aliases-only --filter-tags "lang:go"
+echo "---Both---"
+./gydnc list --aliases-only --count
@@ -0,0 +1,29 @@
+#!/bin/bash
+set -e
+
+cat > config.yml <<EOF2
+default_backend: main
+storage_backends:
+  main:
+    type: localfs
+    localfs:
+      path: .gydnc
+EOF2
+mkdir -p .gydnc
+export GYDNC_CONFIG=./config.yml
+
+cat > .gydnc/errors.g6e <<'EOF2'
+---
+title: Errors
+tags:
+  - lang:go
+---
+# Errors
+
+Always   wrap errors with context before returning them.
+EOF2
+
+echo "---Preview---"
+./gydnc list --preview 30
+echo "---Table---"
+./gydnc list --preview 8 --output table
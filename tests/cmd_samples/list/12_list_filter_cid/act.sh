@@ -0,0 +1,25 @@
+#!/bin/bash
+set -e
+
+cat > config.yml <<EOF2
+default_backend: main
+storage_backends:
+  main:
+    type: localfs
+    localfs:
+      path: .gydnc
+EOF2
+mkdir -p .gydnc
+export GYDNC_CONFIG=./config.yml
+
+./gydnc create alpha --title "Alpha" --body "alpha" >/dev/null 2>&1 || { echo 'create failed'; exit 1; }
+./gydnc create beta --title "Beta" --body "beta" >/dev/null 2>&1 || { echo 'create failed'; exit 1; }
+
+echo "---Short prefix---"
+./gydnc list --filter-cid B6A9 --aliases-only
+echo "---With CID---"
+./gydnc list --filter-cid f2c8
+echo "---No match---"
+./gydnc list --filter-cid 0000 --count
+echo "---Invalid prefix---"
+./gydnc list --filter-cid xyz 2>&1 || echo "exit=$?"
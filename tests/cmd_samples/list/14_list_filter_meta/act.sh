@@ -0,0 +1,24 @@
+#!/bin/bash
+set -e
+
+cat > config.yml <<EOF2
+default_backend: main
+storage_backends:
+  main:
+    type: localfs
+    localfs:
+      path: .gydnc
+EOF2
+mkdir -p .gydnc
+export GYDNC_CONFIG=./config.yml
+
+printf -- '---\ntitle: Must\ntier: must\nstatus: active\n---\nbody\n' > .gydnc/must.g6e
+printf -- '---\ntitle: Should\ntier: should\n---\nbody\n' > .gydnc/should.g6e
+printf -- '---\ntitle: Plain\n---\nbody\n' > .gydnc/plain.g6e
+
+echo "---Equals---"
+./gydnc list --filter-tags "meta:tier=must" --aliases-only
+echo "---Present---"
+./gydnc list --filter-tags "meta:tier" --aliases-only
+echo "---Negated---"
+./gydnc list --filter-tags "-meta:status" --aliases-only
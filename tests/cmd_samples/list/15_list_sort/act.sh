@@ -0,0 +1,25 @@
+#!/bin/bash
+set -e
+
+cat > config.yml <<EOF2
+default_backend: main
+storage_backends:
+  main:
+    type: localfs
+    localfs:
+      path: .gydnc
+EOF2
+mkdir -p .gydnc
+export GYDNC_CONFIG=./config.yml
+
+./gydnc create alpha --title "Zulu" --tags "a,b" >/dev/null 2>&1 </dev/null
+./gydnc create beta --title "Mike" --tags "a,b,c" >/dev/null 2>&1 </dev/null
+./gydnc create gamma --title "Alpha" --tags "a,b" >/dev/null 2>&1 </dev/null
+./gydnc create delta --title "Kilo" >/dev/null 2>&1 </dev/null
+
+echo "---Title---"
+./gydnc list --sort title --aliases-only
+echo "---Tagcount reversed---"
+./gydnc list --sort tagcount --reverse --output table --no-header
+echo "---Unknown---"
+./gydnc list --sort size >/dev/null 2>&1 || echo "exit=$?"
@@ -0,0 +1,28 @@
+#!/bin/bash
+set -e
+
+cat > config.yml <<EOF2
+default_backend: main
+storage_backends:
+  main:
+    type: localfs
+    localfs:
+      path: .gydnc
+EOF2
+mkdir -p .gydnc
+export GYDNC_CONFIG=./config.yml
+
+./gydnc create alpha --title "Alpha" --body "alpha" >/dev/null 2>&1 </dev/null || { echo 'create failed'; exit 1; }
+
+expected=$(printf 'main\0alpha' | sha256sum | cut -d' ' -f1)
+first=$(./gydnc list --jsonl --with-id)
+echo "$first" | sed "s/$expected/<id>/"
+
+./gydnc update alpha --title "Alpha Renamed" >/dev/null 2>&1 </dev/null || { echo 'update failed'; exit 1; }
+second=$(./gydnc list --jsonl --with-id)
+echo "$second" | sed "s/$expected/<id>/"
+
+echo "---Extended---"
+./gydnc list --jsonl --extended --with-id | grep -o '"id":"[^"]*"' | sed "s/$expected/<id>/"
+echo "---Without---"
+./gydnc list --jsonl | grep -c '"id"' || true
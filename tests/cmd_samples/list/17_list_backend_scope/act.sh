@@ -0,0 +1,30 @@
+#!/bin/bash
+set -e
+
+cat > config.yml <<EOF2
+default_backend: one
+storage_backends:
+  one:
+    type: localfs
+    localfs:
+      path: one_data
+  two:
+    type: localfs
+    localfs:
+      path: two_data
+EOF2
+mkdir -p one_data two_data
+export GYDNC_CONFIG=./config.yml
+
+./gydnc create alpha --backend one --tags "scope:code" --body "a" >/dev/null 2>&1 </dev/null || { echo 'create failed'; exit 1; }
+./gydnc create beta --backend one --tags "scope:docs" --body "b" >/dev/null 2>&1 </dev/null || { echo 'create failed'; exit 1; }
+./gydnc create gamma --backend two --tags "scope:code" --body "c" >/dev/null 2>&1 </dev/null || { echo 'create failed'; exit 1; }
+
+echo "---All---"
+./gydnc list --aliases-only
+echo "---Two---"
+./gydnc list --backend two --aliases-only
+echo "---One filtered---"
+./gydnc list --backend one --filter-tags "scope:code" --aliases-only
+echo "---Unknown---"
+./gydnc list --backend three --aliases-only 2>&1 || echo "exit: $?"
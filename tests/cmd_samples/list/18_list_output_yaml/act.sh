@@ -0,0 +1,23 @@
+#!/bin/bash
+set -e
+
+cat > config.yml <<EOF2
+default_backend: main
+storage_backends:
+  main:
+    type: localfs
+    localfs:
+      path: .gydnc
+EOF2
+mkdir -p .gydnc
+export GYDNC_CONFIG=./config.yml
+
+./gydnc create alpha --title "Alpha" --tags "scope:code" --body "alpha" >/dev/null 2>&1 </dev/null || { echo 'create failed'; exit 1; }
+./gydnc create beta --title "Beta" --description "Second" --body "beta" >/dev/null 2>&1 </dev/null || { echo 'create failed'; exit 1; }
+
+echo "---Compact---"
+./gydnc list --output yaml
+echo "---Extended---"
+./gydnc list --output yaml --extended --filter-tags "scope:code"
+echo "---Empty---"
+./gydnc list --output yaml --filter-tags "scope:none"
@@ -0,0 +1,29 @@
+#!/bin/bash
+set -e
+
+cat > config.yml <<EOF2
+default_backend: one
+storage_backends:
+  one:
+    type: localfs
+    localfs:
+      path: one_data
+  two:
+    type: localfs
+    localfs:
+      path: two_data
+EOF2
+mkdir -p one_data two_data
+export GYDNC_CONFIG=./config.yml
+
+./gydnc create alpha --backend one --title "Alpha one" --body "a" >/dev/null 2>&1 </dev/null || { echo 'create failed'; exit 1; }
+./gydnc create alpha --backend two --title "Alpha two" --body "a2" >/dev/null 2>&1 </dev/null || { echo 'create failed'; exit 1; }
+./gydnc create beta --backend two --title "Beta" --body "b" >/dev/null 2>&1 </dev/null || { echo 'create failed'; exit 1; }
+
+echo "---Override---"
+./gydnc list --jsonl 2>/dev/null
+echo "---Union---"
+./gydnc list --backends union --jsonl
+echo "---Unknown---"
+./gydnc list --backends merge --jsonl 2>&1 | grep -o "unknown --backends mode '[^']*'" || true
+./gydnc list --backends merge --jsonl >/dev/null 2>&1 || echo "exit: $?"
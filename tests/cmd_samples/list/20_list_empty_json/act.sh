@@ -0,0 +1,23 @@
+#!/bin/bash
+set -e
+
+cat > config.yml <<EOF2
+storage_backends:
+  one:
+    type: localfs
+    localfs:
+      path: one_data
+EOF2
+mkdir -p one_data
+export GYDNC_CONFIG=./config.yml
+
+echo "---Default---"
+./gydnc list 2>/dev/null
+echo "---Json---"
+./gydnc list --output json 2>/dev/null
+echo "---Filtered---"
+./gydnc list --output json --filter-tags "scope:none" 2>/dev/null
+echo "---Backend---"
+./gydnc list --output json --backend one 2>/dev/null
+echo "---Jsonl lines---"
+./gydnc list --jsonl 2>/dev/null | wc -l | tr -d ' '
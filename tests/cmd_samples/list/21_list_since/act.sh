@@ -0,0 +1,36 @@
+#!/bin/bash
+set -e
+
+mkdir -p disk_data seed
+cat > seed/pinned.g6e <<'EOF2'
+---
+title: Pinned
+---
+Seeded in memory.
+EOF2
+cat > config.yml <<EOF2
+default_backend: disk
+storage_backends:
+  disk:
+    type: localfs
+    localfs:
+      path: disk_data
+  mem:
+    type: inmem
+    inmem:
+      seed_dir: seed
+EOF2
+export GYDNC_CONFIG=./config.yml
+
+./gydnc create fresh --backend disk --body "new" >/dev/null 2>&1 </dev/null
+./gydnc create stale --backend disk --body "old" >/dev/null 2>&1 </dev/null
+touch -d "2020-01-01T00:00:00Z" disk_data/stale.g6e
+
+echo "---All---"
+./gydnc list --aliases-only 2>/dev/null
+echo "---Since 24h---"
+./gydnc list --since 24h --aliases-only 2>/dev/null
+echo "---Warning---"
+./gydnc list --since 24h --aliases-only 2>&1 >/dev/null | grep -o 'msg="[^"]*" backend=[a-z]*'
+echo "---Invalid---"
+./gydnc list --since yesterday --aliases-only >/dev/null 2>&1 || echo "exit: $?"
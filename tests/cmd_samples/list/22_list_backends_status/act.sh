@@ -0,0 +1,32 @@
+#!/bin/bash
+set -e
+
+# The broken backend points below a regular file, so it cannot be initialized
+touch not_a_dir
+cat > config.yml <<EOF2
+default_backend: main
+storage_backends:
+  main:
+    type: localfs
+    localfs:
+      path: main_data
+  extra:
+    type: localfs
+    localfs:
+      path: extra_data
+  broken:
+    type: localfs
+    localfs:
+      path: not_a_dir/store
+EOF2
+mkdir -p main_data extra_data
+export GYDNC_CONFIG=./config.yml
+
+./gydnc create alpha --backend main --body "a" >/dev/null 2>&1 </dev/null
+./gydnc create beta --backend main --body "b" >/dev/null 2>&1 </dev/null
+./gydnc create gamma --backend extra --body "c" >/dev/null 2>&1 </dev/null
+
+echo "---Aliases---"
+./gydnc list --aliases-only --backends-status 2>/dev/null | tr -s ' ' | sed "s#$(pwd)#<dir>#g"
+echo "---Json---"
+./gydnc list --backends-status 2>/dev/null | grep -E '"(name|ok|entities)"|"alias"' | tr -d ' '
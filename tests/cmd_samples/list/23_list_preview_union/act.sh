@@ -0,0 +1,25 @@
+#!/bin/bash
+set -e
+
+cat > config.yml <<EOF2
+default_backend: one
+storage_backends:
+  one:
+    type: localfs
+    localfs:
+      path: one_data
+  two:
+    type: localfs
+    localfs:
+      path: two_data
+EOF2
+mkdir -p one_data two_data
+export GYDNC_CONFIG=./config.yml
+
+./gydnc create rule --backend one --title "Rule" --body "body from one" >/dev/null 2>&1 </dev/null
+./gydnc create rule --backend two --title "Rule" --body "body from two" >/dev/null 2>&1 </dev/null
+
+echo "---Union JSON---"
+./gydnc list --backends union --preview 20 --jsonl 2>/dev/null
+echo "---Union table---"
+./gydnc list --backends union --preview 20 --output table 2>/dev/null
@@ -0,0 +1,17 @@
+#!/bin/bash
+set -e
+
+./gydnc init >/dev/null 2>&1 || { echo 'init failed'; exit 1; }
+
+CONFIG_FILE=".gydnc/config.yml"
+
+# Create an entity with a description and tags that the update will clear
+./gydnc create --config "${CONFIG_FILE}" test/mcp-clear-test --title "MCP Clear Test" --description "Obsolete description" --tags "test,mcp" --body "Original body" >/dev/null 2>&1 || { echo 'create failed'; exit 1; }
+
+# Explicit empty values clear fields; omitted fields (title, body) are kept
+(
+  printf '{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2024-11-05","capabilities":{},"clientInfo":{"name":"test","version":"1.0"}}}\n'
+  sleep 0.5
+  printf '{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"gydnc_write","arguments":{"operation":"update","alias":"test/mcp-clear-test","description":"","tags":[]}}}\n'
+  sleep 0.5
+) | timeout 5 ./gydnc --config "${CONFIG_FILE}" mcp-server 2>&1
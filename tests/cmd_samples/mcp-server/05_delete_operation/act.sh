@@ -0,0 +1,21 @@
+#!/bin/bash
+set -e
+
+./gydnc init >/dev/null 2>&1 || { echo 'init failed'; exit 1; }
+
+CONFIG_FILE=".gydnc/config.yml"
+
+./gydnc --config "${CONFIG_FILE}" create test/mcp-delete-test --title "MCP Delete Test" >/dev/null 2>&1 || { echo 'create failed'; exit 1; }
+
+# Delete an existing entity, then delete it again (not found is reported, not an RPC error)
+(
+  printf '{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2024-11-05","capabilities":{},"clientInfo":{"name":"test","version":"1.0"}}}\n'
+  sleep 0.5
+  printf '{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"gydnc_delete","arguments":{"alias":"test/mcp-delete-test"}}}\n'
+  sleep 0.5
+  printf '{"jsonrpc":"2.0","id":3,"method":"tools/call","params":{"name":"gydnc_delete","arguments":{"alias":"test/mcp-delete-test"}}}\n'
+  sleep 0.5
+) | timeout 5 ./gydnc --config "${CONFIG_FILE}" mcp-server 2>&1
+
+echo "---After delete---"
+./gydnc --config "${CONFIG_FILE}" list --count
@@ -0,0 +1,30 @@
+#!/bin/bash
+set -e
+
+cat > config.yml <<EOF2
+default_backend: main
+storage_backends:
+  main:
+    type: localfs
+    localfs:
+      path: ./main
+  shared:
+    type: localfs
+    localfs:
+      path: ./shared
+EOF2
+mkdir -p main shared
+
+cat > shared/team-style.g6e <<'EOF2'
+---
+title: Team Style
+---
+Shared guidance.
+EOF2
+
+(
+  printf '{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2024-11-05","capabilities":{},"clientInfo":{"name":"test","version":"1.0"}}}\n'
+  sleep 0.5
+  printf '{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"gydnc_read","arguments":{"operation":"get","aliases":["team-style"]}}}\n'
+  sleep 0.5
+) | timeout 5 ./gydnc --config ./config.yml mcp-server 2>&1
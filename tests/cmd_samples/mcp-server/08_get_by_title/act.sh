@@ -0,0 +1,17 @@
+#!/bin/bash
+set -e
+
+./gydnc init >/dev/null 2>&1 || { echo 'init failed'; exit 1; }
+
+CONFIG_FILE=".gydnc/config.yml"
+
+./gydnc --config "${CONFIG_FILE}" create go/errors --title "Error Handling" --body "Wrap errors with context." >/dev/null 2>&1 || { echo 'create failed'; exit 1; }
+./gydnc --config "${CONFIG_FILE}" create go/style-a --title "Style" --body "Style A." >/dev/null 2>&1 || { echo 'create failed'; exit 1; }
+./gydnc --config "${CONFIG_FILE}" create go/style-b --title "Style" --body "Style B." >/dev/null 2>&1 || { echo 'create failed'; exit 1; }
+
+(
+  printf '{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2024-11-05","capabilities":{},"clientInfo":{"name":"test","version":"1.0"}}}\n'
+  sleep 0.5
+  printf '{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"gydnc_read","arguments":{"operation":"get","match_by":"title","aliases":["error handling","Style"]}}}\n'
+  sleep 0.5
+) | timeout 5 ./gydnc --config "${CONFIG_FILE}" mcp-server 2>&1
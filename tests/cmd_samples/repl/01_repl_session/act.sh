@@ -0,0 +1,22 @@
+#!/bin/bash
+set -e
+
+./gydnc init >/dev/null 2>&1
+export GYDNC_CONFIG=./.gydnc/config.yml
+
+./gydnc create alpha --title "Alpha" --tags "scope:code" --body "alpha body" >/dev/null 2>&1 </dev/null || { echo 'create failed'; exit 1; }
+./gydnc create beta --title "Beta" --tags "scope:docs" --body "beta body" >/dev/null 2>&1 </dev/null || { echo 'create failed'; exit 1; }
+
+./gydnc repl 2>&1 <<'INPUT'
+# comments and blank lines are ignored
+
+list --aliases-only
+filter "scope:code" --aliases-only
+list --count
+get beta --output raw
+list --backend missing
+create gamma
+list --aliases-only --reverse
+exit
+list --count
+INPUT
@@ -0,0 +1,32 @@
+#!/bin/bash
+set -e
+
+cat > config.yml <<EOF2
+default_backend: one
+storage_backends:
+  one:
+    type: localfs
+    localfs:
+      path: one_data
+  two:
+    type: localfs
+    localfs:
+      path: two_data
+EOF2
+mkdir -p one_data two_data
+export GYDNC_CONFIG=./config.yml
+
+./gydnc create alpha --backend one --tags "scope:code,quality:safe" --body "a" >/dev/null 2>&1 </dev/null || { echo 'create failed'; exit 1; }
+./gydnc create beta --backend one --tags "quality:safe,quality:safety" --body "b" >/dev/null 2>&1 </dev/null || { echo 'create failed'; exit 1; }
+./gydnc create gamma --backend two --tags "quality:safe" --body "c" >/dev/null 2>&1 </dev/null || { echo 'create failed'; exit 1; }
+./gydnc create delta --backend two --tags "scope:docs" --body "d" >/dev/null 2>&1 </dev/null || { echo 'create failed'; exit 1; }
+
+echo "---Dry run---"
+./gydnc retag --from quality:safe --to quality:safety --dry-run
+./gydnc list --backend one --filter-tags "quality:safe" --aliases-only
+
+echo "---Retag---"
+./gydnc retag --from quality:safe --to quality:safety 2>/dev/null
+echo "---After---"
+./gydnc list --filter-tags "quality:safe" --count
+./gydnc list --backend two --filter-tags "quality:safety" --aliases-only
@@ -0,0 +1,27 @@
+#!/bin/bash
+set -e
+
+cat > config.yml <<EOF2
+default_backend: one
+storage_backends:
+  one:
+    type: localfs
+    localfs:
+      path: one_data
+  two:
+    type: localfs
+    localfs:
+      path: two_data
+EOF2
+mkdir -p one_data two_data
+export GYDNC_CONFIG=./config.yml
+
+printf '# Rule\n\nUse **short** names like `id`.\n' | ./gydnc create naming --backend one --title "Naming" --description "How to name things" --tags "scope:code,quality:clarity" >/dev/null 2>&1
+./gydnc create naming --backend two --title "Naming (team)" --body "Team rule" >/dev/null 2>&1 </dev/null
+
+echo "---Default---"
+./gydnc show naming
+echo "---Backend two---"
+./gydnc show naming --backend two
+echo "---Color---"
+./gydnc show naming --color always | cat -v
@@ -0,0 +1,30 @@
+#!/bin/bash
+set -e
+
+cat > config.yml <<EOF2
+default_backend: one
+storage_backends:
+  one:
+    type: localfs
+    localfs:
+      path: one_data
+  two:
+    type: localfs
+    localfs:
+      path: two_data
+EOF2
+mkdir -p one_data two_data
+export GYDNC_CONFIG=./config.yml
+
+./gydnc create alpha --backend one --title "Alpha" --tags "scope:code" --body "hello" >/dev/null 2>&1 </dev/null
+./gydnc create alpha --backend two --title "Alpha two" --body "other" >/dev/null 2>&1 </dev/null
+touch -d "2024-01-02T03:04:05Z" one_data/alpha.g6e
+cid=$(./gydnc hash alpha 2>/dev/null | cut -d' ' -f2)
+size=$(wc -c < one_data/alpha.g6e | tr -d ' ')
+
+echo "---Default---"
+./gydnc stat alpha 2>/dev/null | sed "s#$cid#<cid>#; s#^size: $size\$#size: <size>#; s#$(pwd)#<dir>#"
+echo "---Backend two---"
+./gydnc stat alpha --backend two --output json 2>/dev/null | grep -E '"(alias|backend|title)"'
+echo "---Missing---"
+./gydnc stat nope 2>&1 | grep -v '^level=' || true
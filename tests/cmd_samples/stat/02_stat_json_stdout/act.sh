@@ -0,0 +1,18 @@
+#!/bin/bash
+set -e
+
+mkdir -p seed
+printf -- '---\ntitle: Demo\n---\nDemo body.\n' > seed/demo.g6e
+
+# The default backend is not configured, which is reported as a notice on stderr
+cat > config.yml <<EOF2
+default_backend: missing
+storage_backends:
+  demo:
+    type: inmem
+    inmem:
+      seed_dir: seed
+EOF2
+export GYDNC_CONFIG=./config.yml
+
+./gydnc stat demo --output json
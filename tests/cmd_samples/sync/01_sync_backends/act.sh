@@ -0,0 +1,31 @@
+#!/bin/bash
+set -euo pipefail
+
+cat > config.yml <<EOF2
+default_backend: staging
+storage_backends:
+  staging:
+    type: localfs
+    localfs:
+      path: .store_staging
+  prod:
+    type: localfs
+    localfs:
+      path: .store_prod
+EOF2
+mkdir -p .store_staging .store_prod
+export GYDNC_CONFIG=./config.yml
+
+./gydnc create same --title "Same" --body "Same body" --backend staging 2>/dev/null
+./gydnc create same --title "Same" --body "Same body" --backend prod 2>/dev/null
+./gydnc create changed --title "Changed" --body "New body" --backend staging 2>/dev/null
+./gydnc create changed --title "Changed" --body "Old body" --backend prod 2>/dev/null
+./gydnc create fresh --title "Fresh" --body "Fresh body" --backend staging 2>/dev/null
+./gydnc create obsolete --title "Obsolete" --backend prod 2>/dev/null
+
+echo "---Dry run---"
+./gydnc sync staging prod --delete --dry-run 2>/dev/null
+echo "---Apply---"
+./gydnc sync --from staging --to prod --delete --force 2>/dev/null
+echo "---After---"
+./gydnc list --changed-vs prod --backend staging
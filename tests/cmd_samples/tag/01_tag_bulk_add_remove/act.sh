@@ -0,0 +1,25 @@
+#!/bin/bash
+set -e
+
+./gydnc init >/dev/null 2>&1
+export GYDNC_CONFIG=./.gydnc/config.yml
+
+./gydnc create alpha --tags "scope:code,legacy" --body "a" >/dev/null 2>&1 </dev/null || { echo 'create failed'; exit 1; }
+./gydnc create beta --tags "scope:code" --body "b" >/dev/null 2>&1 </dev/null || { echo 'create failed'; exit 1; }
+./gydnc create gamma --tags "scope:docs,legacy" --body "c" >/dev/null 2>&1 </dev/null || { echo 'create failed'; exit 1; }
+
+echo "---Declined---"
+echo n | ./gydnc tag add quality:reviewed --filter-tags "scope:code"
+./gydnc list --filter-tags "quality:reviewed" --count
+
+echo "---Add---"
+./gydnc tag add quality:reviewed --filter-tags "scope:code" --force
+echo "---Add again---"
+./gydnc tag add quality:reviewed --filter-tags "scope:code" --force
+
+echo "---Remove---"
+echo y | ./gydnc tag remove legacy --filter-tags "legacy"
+./gydnc list --filter-tags "legacy" --count
+
+echo "---No filter---"
+./gydnc tag add x 2>&1 || echo "exit: $?"
@@ -0,0 +1,17 @@
+#!/bin/bash
+set -e
+
+./gydnc init . > /dev/null 2>&1
+export GYDNC_CONFIG=.gydnc/config.yml
+
+cat > .gydnc/hand-edited.g6e <<EOF2
+---
+title: Original
+author: jane
+severity: high
+---
+Body kept as is.
+EOF2
+
+./gydnc update hand-edited --title "Renamed" 2>/dev/null
+cat .gydnc/hand-edited.g6e
@@ -0,0 +1,15 @@
+#!/bin/bash
+set -e
+
+./gydnc init . > /dev/null 2>&1
+export GYDNC_CONFIG=.gydnc/config.yml
+
+./gydnc create lineage --title "Lineage" --body "first" >/dev/null 2>&1 </dev/null
+
+echo "---Created---"
+./gydnc get lineage --extended --fields title
+echo "second" | ./gydnc update lineage 2>/dev/null
+echo "---Updated---"
+./gydnc get lineage --extended --fields title
+echo "---File---"
+cat .gydnc/lineage.g6e
@@ -0,0 +1,16 @@
+#!/bin/bash
+set -e
+
+./gydnc init . > /dev/null 2>&1
+export GYDNC_CONFIG=.gydnc/config.yml
+
+cat > .gydnc/reviewed.g6e <<EOF2
+---
+title: Reviewed
+owner: team-a
+---
+Unchanged body.
+EOF2
+
+./gydnc update reviewed --touch 2>/dev/null
+cat .gydnc/reviewed.g6e
@@ -0,0 +1,30 @@
+#!/bin/bash
+set -e
+
+cat > config.yml <<EOF2
+default_backend: one
+storage_backends:
+  one:
+    type: localfs
+    localfs:
+      path: one_data
+  two:
+    type: localfs
+    localfs:
+      path: two_data
+EOF2
+mkdir -p one_data two_data
+export GYDNC_CONFIG=./config.yml
+
+./gydnc create shared --backend one --title "Shared One" --body "one" >/dev/null 2>&1 </dev/null || { echo 'create failed'; exit 1; }
+./gydnc create shared --backend two --title "Shared Two" --body "two" >/dev/null 2>&1 </dev/null || { echo 'create failed'; exit 1; }
+
+./gydnc update shared --backend two --title "Shared Two Updated" </dev/null 2>/dev/null
+grep '^title:' one_data/shared.g6e
+grep '^title:' two_data/shared.g6e
+
+./gydnc update shared --title "Shared One Updated" </dev/null 2>/dev/null
+grep '^title:' one_data/shared.g6e
+grep '^title:' two_data/shared.g6e
+
+./gydnc update shared --backend three --title "x" </dev/null 2>&1 | grep -v "^level=" || true
@@ -0,0 +1,25 @@
+#!/bin/bash
+set -e
+
+./gydnc init >/dev/null 2>&1
+export GYDNC_CONFIG=./.gydnc/config.yml
+
+cat > .gydnc/rule.g6e <<'EOF2'
+---
+title: Rule
+tags:
+    - scope:code
+status: draft
+draft_note: revisit later
+---
+Body
+EOF2
+
+./gydnc update rule --set-meta status=approved --set-meta "tier=gold, internal" --clear-meta draft_note </dev/null 2>/dev/null
+echo "---File---"
+cat .gydnc/rule.g6e
+echo "---Reserved---"
+./gydnc update rule --set-meta title=Other </dev/null 2>&1 | grep -v '^level=' || true
+./gydnc update rule --clear-meta pcid </dev/null 2>&1 | grep -v '^level=' || true
+echo "---Invalid---"
+./gydnc update rule --set-meta status </dev/null 2>&1 | grep -v '^level=' || true
@@ -0,0 +1,20 @@
+#!/bin/bash
+set -u
+
+./gydnc init >/dev/null 2>&1
+export GYDNC_CONFIG=./.gydnc/config.yml
+
+echo "Return errors." | ./gydnc create no-panics --title "No Panics" --tags scope:code >/dev/null 2>&1
+./gydnc update no-panics --set-meta tier=must >/dev/null 2>&1 </dev/null
+
+echo "=== Unmodified round trip is a no-op ==="
+./gydnc get no-panics | ./gydnc update no-panics --from-stdin 2>&1 | grep -o 'No changes detected for entity. Update not performed.'
+
+echo "=== Modified round trip keeps custom fields ==="
+./gydnc get no-panics | sed 's/"No Panics"/"Never Panic"/; s/"scope:code"/"scope:library"/' \
+  | ./gydnc update no-panics --from-stdin 2>/dev/null
+./gydnc get no-panics --output raw
+
+echo "=== YAML document with a flag applied on top ==="
+printf 'title: From YAML\nbody: |\n  New body.\n' | ./gydnc update no-panics --from-stdin --format yaml --add-tag reviewed 2>/dev/null
+./gydnc get no-panics --output raw
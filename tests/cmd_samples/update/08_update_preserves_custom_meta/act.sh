@@ -0,0 +1,21 @@
+#!/bin/bash
+set -euo pipefail
+
+./gydnc init >/dev/null 2>&1
+export GYDNC_CONFIG=./.gydnc/config.yml
+
+# An entity with custom frontmatter written directly to the store
+cat > .gydnc/authored.g6e <<'G6E'
+---
+title: Authored
+tags:
+    - scope:code
+author: jdoe
+review:
+    cycle: quarterly
+---
+Body.
+G6E
+
+./gydnc update authored --add-tag quality:style </dev/null 2>/dev/null
+cat .gydnc/authored.g6e
@@ -0,0 +1,17 @@
+#!/bin/bash
+set -u
+
+./gydnc init >/dev/null 2>&1
+export GYDNC_CONFIG=./.gydnc/config.yml
+
+printf '# Rule\n\nOriginal text.\n' | ./gydnc create rule --title "Rule" >/dev/null 2>&1
+
+printf '## Examples\n\nAppended section.\n' | ./gydnc update rule --append 2>/dev/null
+printf '> Draft\n' | ./gydnc update rule --prepend 2>/dev/null
+./gydnc get rule --fields body --output raw
+
+echo "=== Empty append is a no-op ==="
+printf '' | ./gydnc update rule --append 2>&1 | grep -o 'No changes detected for entity. Update not performed.'
+
+echo "=== Errors ==="
+printf 'x\n' | ./gydnc update rule --append --prepend 2>&1 | grep -o -- '--append cannot be combined with --prepend'
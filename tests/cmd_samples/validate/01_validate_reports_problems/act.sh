@@ -0,0 +1,22 @@
+#!/bin/bash
+set -euo pipefail
+
+cat > config.yml <<EOF2
+default_backend: main
+storage_backends:
+  main:
+    type: localfs
+    localfs:
+      path: .store_main
+EOF2
+mkdir -p .store_main
+export GYDNC_CONFIG=./config.yml
+
+./gydnc create good --title "Good" --tags "lang:go,recipe" --body "Fine" 2>/dev/null
+printf -- '---\ntitle: ""\ntags:\n  - "bad tag"\n---\nBody\n' > .store_main/untitled.g6e
+printf -- 'no frontmatter here\n' > .store_main/broken.g6e
+
+echo "---Text---"
+./gydnc validate 2>/dev/null || echo "exit=$?"
+echo "---JSON---"
+./gydnc validate --json 2>/dev/null || echo "exit=$?"